@@ -0,0 +1,69 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// archiveCmd represents the archive command
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Used to archive a post",
+	RunE:  archivePost,
+}
+
+func archivePost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get verbose flag
+	verbose, err := cmd.Flags().GetBool(verboseFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if verbose {
+		ui.PrintInfo("Archiving post with ID: %d\n", post.ID)
+	}
+
+	archivedPost, err := db.ArchivePost(ctx, int(post.ID))
+	if err != nil {
+		return fmt.Errorf("failed to archive post: %w", err)
+	}
+
+	ui.PrintSuccess("Post archived successfully!\n")
+	printPostStatus(archivedPost)
+
+	return nil
+}
+
+func init() {
+	postsCmd.AddCommand(archiveCmd)
+
+	archiveCmd.Flags().Int(idFlagName, 0, "ID of the post to archive")
+	archiveCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to archive")
+}
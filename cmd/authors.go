@@ -0,0 +1,87 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/forms"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	emailFlagName     = "email"
+	bioFlagName       = "bio"
+	avatarURLFlagName = "avatar-url"
+	nameFlagName      = "name"
+)
+
+// authorsCmd represents the authors command
+var authorsCmd = &cobra.Command{
+	Use:   "authors",
+	Short: "Used to manage the authors resource",
+	Long: `Manage the authors posts can be written by. Posts can only be saved with
+an author on this list, however the author was given: --author, the editor,
+the TUI, "cms posts import" or the API. "cms posts create" and "cms posts update"
+offer to add an --author who isn't on it yet.`,
+}
+
+func init() {
+	rootCmd.AddCommand(authorsCmd)
+}
+
+// resolveAuthor checks that an author name given on the command line belongs
+// to a known author and returns the name as it's stored. An unknown author is
+// added once the user confirms it; without a TTY to ask on it is an error.
+// Adding one is noted on stderr, so it doesn't mix with --output
+func resolveAuthor(ctx context.Context, cmd *cobra.Command, db database.Storage, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil
+	}
+
+	author, err := db.GetAuthorByName(ctx, name)
+	if err == nil {
+		return author.Name, nil
+	}
+	if !errors.Is(err, database.ErrAuthorNotFound) {
+		return "", fmt.Errorf("failed to get author: %w", err)
+	}
+
+	authors, err := db.ListAuthors(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list authors: %w", err)
+	}
+
+	names := make([]string, len(authors))
+	for i, author := range authors {
+		names[i] = author.Name
+	}
+
+	if !ui.IsTerminal() {
+		msg := fmt.Sprintf("author %q not found, add them with: cms authors create %q", name, name)
+		if similar := forms.SimilarNames(name, names); len(similar) > 0 {
+			msg += fmt.Sprintf(" (did you mean %s?)", strings.Join(similar, ", "))
+		}
+		return "", errors.New(msg)
+	}
+
+	if err := forms.ConfirmCreateAuthor(name, names); err != nil {
+		return "", err
+	}
+
+	created, err := db.CreateAuthor(ctx, database.Author{Name: name})
+	if err != nil {
+		return "", fmt.Errorf("failed to create author: %w", err)
+	}
+
+	fmt.Fprintln(cmd.ErrOrStderr(), ui.SuccessString("✅ Author '%s' created", created.Name))
+	return created.Name, nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// authorsCreateCmd represents the authors create command
+var authorsCreateCmd = &cobra.Command{
+	Use:     "create <name>",
+	Aliases: []string{"add"},
+	Short:   "Used to add an author",
+	Long: `Add an author that posts can be written by.
+
+Examples:
+  cms authors create "Jane Doe" --email jane@example.com --bio "Writes about Go"`,
+	Args: cobra.ExactArgs(1),
+	RunE: createAuthor,
+}
+
+func createAuthor(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	author := database.Author{Name: args[0]}
+	for flag, field := range authorFlagFields(&author) {
+		value, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return err
+		}
+		*field = database.StringToNullString(value)
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	created, err := db.CreateAuthor(ctx, author)
+	if err != nil {
+		return fmt.Errorf("failed to create author: %w", err)
+	}
+
+	ui.PrintSuccess("Author '%s' created\n", created.Name)
+
+	return nil
+}
+
+// authorFlagFields maps the optional author detail flags to the fields they set
+func authorFlagFields(author *database.Author) map[string]*sql.NullString {
+	return map[string]*sql.NullString{
+		emailFlagName:     &author.Email,
+		bioFlagName:       &author.Bio,
+		avatarURLFlagName: &author.AvatarUrl,
+	}
+}
+
+func init() {
+	authorsCmd.AddCommand(authorsCreateCmd)
+
+	authorsCreateCmd.Flags().String(emailFlagName, "", "Email address of the author")
+	authorsCreateCmd.Flags().String(bioFlagName, "", "Short biography of the author")
+	authorsCreateCmd.Flags().String(avatarURLFlagName, "", "URL of the author's avatar image")
+}
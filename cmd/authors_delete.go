@@ -0,0 +1,70 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// authorsDeleteCmd represents the authors delete command
+var authorsDeleteCmd = &cobra.Command{
+	Use:     "delete <name>",
+	Aliases: []string{"rm"},
+	Short:   "Used to delete an author",
+	Long: `Delete an author. An author who wrote posts, including posts in the trash,
+is only deleted with --force, which leaves those posts without an author.`,
+	Args: cobra.ExactArgs(1),
+	RunE: deleteAuthor,
+}
+
+func deleteAuthor(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get force flag
+	force, err := cmd.Flags().GetBool(forceFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	count, err := db.DeleteAuthor(ctx, args[0], force)
+	if errors.Is(err, database.ErrAuthorHasPosts) {
+		ui.PrintWarning("'%s' wrote %d post(s). Use --force to delete them anyway and leave their posts without an author.\n", args[0], count)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete author: %w", err)
+	}
+
+	ui.PrintSuccess("Author '%s' deleted\n", args[0])
+	if count > 0 {
+		ui.PrintInfo("%d post(s) no longer have an author\n", count)
+	}
+
+	return nil
+}
+
+func init() {
+	authorsCmd.AddCommand(authorsDeleteCmd)
+
+	authorsDeleteCmd.Flags().BoolP(forceFlagName, "f", false, "Delete an author who wrote posts")
+}
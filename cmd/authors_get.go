@@ -0,0 +1,82 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// authorsGetCmd represents the authors get command
+var authorsGetCmd = &cobra.Command{
+	Use:   "get <name>",
+	Short: "Used to show an author's details",
+	Args:  cobra.ExactArgs(1),
+	RunE:  getAuthor,
+}
+
+func getAuthor(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	found, err := db.GetAuthorByName(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get author: %w", err)
+	}
+
+	// The post count comes with the author list
+	authors, err := db.ListAuthors(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list authors: %w", err)
+	}
+
+	var author database.AuthorWithCount
+	for _, a := range authors {
+		if a.ID == found.ID {
+			author = a
+		}
+	}
+
+	if !printer.IsTable() {
+		return printer.Print(output.NewAuthor(author))
+	}
+
+	ui.Header("Author Details")
+	ui.Field("Name", ui.HighlightString(author.Name))
+	ui.FieldIfNotEmpty("Email", database.NullStringToString(author.Email))
+	ui.FieldIfNotEmpty("Avatar", database.NullStringToString(author.AvatarUrl))
+	ui.FieldIfNotEmpty("Bio", database.NullStringToString(author.Bio))
+	ui.Field("Posts", author.PostCount)
+	if author.CreatedAt.Valid {
+		ui.Field("Created", author.CreatedAt.Time.Local().Format("2006-01-02 15:04:05"))
+	}
+
+	return nil
+}
+
+func init() {
+	authorsCmd.AddCommand(authorsGetCmd)
+}
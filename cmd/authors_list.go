@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// authorsListCmd represents the authors list command
+var authorsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Used to list all authors and how many posts they wrote",
+	Args:  cobra.NoArgs,
+	RunE:  listAuthors,
+}
+
+func listAuthors(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	authors, err := db.ListAuthors(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list authors: %w", err)
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !printer.IsTable() {
+		items := make([]output.Author, len(authors))
+		for i, author := range authors {
+			items[i] = output.NewAuthor(author)
+		}
+		return printer.PrintList(items)
+	}
+
+	if len(authors) == 0 {
+		fmt.Println("✍️  No authors found.")
+		return nil
+	}
+
+	ui.Header("Authors")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("NAME\tEMAIL\tPOSTS"))
+	fmt.Fprintln(w, ui.SubtleString("----\t-----\t-----"))
+
+	for _, author := range authors {
+		fmt.Fprintf(w, "%s\t%s\t%d\n",
+			author.Name,
+			ui.SubtleString(database.NullStringToString(author.Email)),
+			author.PostCount,
+		)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+	ui.PrintInfo("Found %d author(s)\n", len(authors))
+
+	return nil
+}
+
+func init() {
+	authorsCmd.AddCommand(authorsListCmd)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// authorsUpdateCmd represents the authors update command
+var authorsUpdateCmd = &cobra.Command{
+	Use:   "update <name>",
+	Short: "Used to update an author's details",
+	Long: `Update an author's details. Only the flags given are changed, and an empty
+value clears a detail. Renaming an author renames them on all of their posts.
+
+Examples:
+  cms authors update "Jane Doe" --email jane@example.org
+  cms authors update "Jane Doe" --name "Jane Smith"`,
+	Args: cobra.ExactArgs(1),
+	RunE: updateAuthor,
+}
+
+func updateAuthor(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	flags := []string{nameFlagName, emailFlagName, bioFlagName, avatarURLFlagName}
+	changed := false
+	for _, flag := range flags {
+		changed = changed || cmd.Flags().Changed(flag)
+	}
+	if !changed {
+		return errors.New("at least one field must be specified to update (--name, --email, --bio or --avatar-url)")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	existing, err := db.GetAuthorByName(ctx, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get author: %w", err)
+	}
+
+	// Start with the existing details and override the ones given
+	updates := *existing
+	if cmd.Flags().Changed(nameFlagName) {
+		updates.Name, err = cmd.Flags().GetString(nameFlagName)
+		if err != nil {
+			return err
+		}
+	}
+	for flag, field := range authorFlagFields(&updates) {
+		if !cmd.Flags().Changed(flag) {
+			continue
+		}
+		value, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return err
+		}
+		*field = database.StringToNullString(value)
+	}
+
+	updated, err := db.UpdateAuthor(ctx, existing.Name, updates)
+	if err != nil {
+		return fmt.Errorf("failed to update author: %w", err)
+	}
+
+	ui.PrintSuccess("Author '%s' updated\n", updated.Name)
+	if updated.Name != existing.Name {
+		ui.Field("Renamed", fmt.Sprintf("%s → %s", existing.Name, updated.Name))
+	}
+
+	return nil
+}
+
+func init() {
+	authorsCmd.AddCommand(authorsUpdateCmd)
+
+	authorsUpdateCmd.Flags().String(nameFlagName, "", "New name for the author")
+	authorsUpdateCmd.Flags().String(emailFlagName, "", "Email address of the author")
+	authorsUpdateCmd.Flags().String(bioFlagName, "", "Short biography of the author")
+	authorsUpdateCmd.Flags().String(avatarURLFlagName, "", "URL of the author's avatar image")
+}
@@ -0,0 +1,173 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/site"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	outFlagName       = "out"
+	templatesFlagName = "templates"
+	staticFlagName    = "static"
+	siteTitleFlagName = "site-title"
+	baseURLFlagName   = "base-url"
+	pageSizeFlagName  = "page-size"
+	cleanFlagName     = "clean"
+)
+
+// buildCmd represents the build command
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Used to build a static HTML site from published posts",
+	Long: `Render every published post to HTML, along with paginated index pages.
+
+Posts are written to <out>/posts/<slug>/index.html and index pages to
+<out>/index.html, <out>/page/2/index.html and so on. Posts without a slug
+are skipped.
+
+Templates are html/template files. --templates replaces the built-in
+index.html, post.html and partials.html with files of the same name, so
+you only need to override what you want to change. Templates can call
+{{url "path"}} to build links relative to --base-url.
+
+Media files posts refer to, as in ![A diagram](media:3f2a9c1b7e4d), are
+copied to <out>/media and the references point at the copies.
+
+Examples:
+  cms build --out ./public
+  cms build --out ./public --templates ./layouts --static ./assets --base-url https://blog.example.com/`,
+	RunE: buildSite,
+}
+
+func buildSite(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	out, err := cmd.Flags().GetString(outFlagName)
+	if err != nil {
+		return err
+	}
+
+	templates, err := cmd.Flags().GetString(templatesFlagName)
+	if err != nil {
+		return err
+	}
+
+	static, err := cmd.Flags().GetString(staticFlagName)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(siteTitleFlagName)
+	if err != nil {
+		return err
+	}
+
+	baseURL, err := cmd.Flags().GetString(baseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	pageSize, err := cmd.Flags().GetInt(pageSizeFlagName)
+	if err != nil {
+		return err
+	}
+
+	clean, err := cmd.Flags().GetBool(cleanFlagName)
+	if err != nil {
+		return err
+	}
+
+	store, err := mediaStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if clean {
+		if err := cleanDir(out); err != nil {
+			return err
+		}
+	}
+
+	result, err := site.Build(ctx, db, site.Config{
+		OutDir:      out,
+		TemplateDir: templates,
+		StaticDir:   static,
+		Title:       title,
+		BaseURL:     baseURL,
+		PageSize:    pageSize,
+		MediaDir:    store.Dir(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build site: %w", err)
+	}
+
+	for _, reason := range result.Skipped {
+		ui.PrintWarning("Skipped: %s\n", reason)
+	}
+
+	ui.PrintSuccess("Built site in %s\n", ui.LinkString(out))
+	ui.Field("Posts", result.Posts)
+	ui.Field("Index pages", result.Pages)
+	ui.Field("Assets", result.Assets)
+	ui.Field("Media", result.Media)
+
+	return nil
+}
+
+// cleanDir removes a previous build, refusing to remove the working directory
+// or anything above it
+func cleanDir(dir string) error {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	rel, err := filepath.Rel(abs, wd)
+	if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("refusing to clean %s as it contains the current directory", dir)
+	}
+
+	return os.RemoveAll(abs)
+}
+
+func init() {
+	rootCmd.AddCommand(buildCmd)
+
+	buildCmd.Flags().String(outFlagName, "./public", "Directory to write the site to")
+	buildCmd.Flags().String(templatesFlagName, "", "Directory of html/template files overriding the built-in templates")
+	buildCmd.Flags().String(staticFlagName, "", "Directory of static assets to copy into the site")
+	buildCmd.Flags().String(siteTitleFlagName, "My Blog", "Title of the site")
+	buildCmd.Flags().String(baseURLFlagName, "/", "Base URL the site is served from")
+	buildCmd.Flags().Int(pageSizeFlagName, site.DefaultPageSize, "Number of posts per index page")
+	buildCmd.Flags().Bool(cleanFlagName, false, "Remove the output directory before building")
+	buildCmd.Flags().String(mediaDirFlagName, "", "Directory of the media store (default: media next to the SQLite database)")
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	localFlagName = "local"
+)
+
+// configCmd represents the config command
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Used to manage settings and profiles",
+	Long: `Manage the settings cms uses when flags don't give them. Settings are kept in
+named profiles in two config files:
+
+  $XDG_CONFIG_HOME/cms/config.yaml  (~/.config/cms/config.yaml by default)
+  .cms.yaml                          in the project, or any directory above it
+
+The project file takes precedence. Relative SQLite paths in a config file are
+relative to the file, so a project can keep its database next to .cms.yaml.
+CMS_DATABASE_URL, CMS_AUTHOR, CMS_EDITOR and CMS_OUTPUT override the config
+files, and flags override everything. CMS_PROFILE or --profile choose a
+profile other than the current one.
+
+Example .cms.yaml:
+
+  current_profile: default
+  profiles:
+    default:
+      database_url: ./cms.db
+      author: Jane Doe
+      editor: code --wait
+    production:
+      database_url: postgres://cms@db.example.com/cms
+      output: json`,
+	// Settings that can't be worked out, such as an unknown profile, are
+	// reported by the commands that need them so the rest can fix them
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := loadSettings(cmd); err != nil {
+			return err
+		}
+		return prepareOutput(cmd)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+}
+
+// configKey accepts a setting key with dashes in place of underscores, as in
+// database-url
+func configKey(key string) string {
+	return strings.ReplaceAll(strings.ToLower(key), "-", "_")
+}
+
+// configFile returns the config file that "cms config" changes: the project's
+// with --local, creating .cms.yaml in the working directory if there isn't
+// one, and otherwise the global one
+func configFile(cmd *cobra.Command) (*config.File, error) {
+	local, err := cmd.Flags().GetBool(localFlagName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !local {
+		return cmsConfig.Global, nil
+	}
+	if cmsConfig.Project != nil {
+		return cmsConfig.Project, nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	return config.LoadFile(filepath.Join(wd, config.ProjectFileName))
+}
@@ -0,0 +1,55 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/config"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// configGetCmd represents the config get command
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Used to print the value of a setting",
+	Long: `Print the value in effect for a setting: ` + strings.Join(config.Keys, ", ") + `.
+Nothing is printed for a setting that isn't set.`,
+	Args: cobra.ExactArgs(1),
+	RunE: getConfig,
+}
+
+func getConfig(cmd *cobra.Command, args []string) error {
+	if settingsErr != nil {
+		return settingsErr
+	}
+
+	key := configKey(args[0])
+	if !slices.Contains(config.Keys, key) {
+		return fmt.Errorf("unknown setting %q (must be one of %s)", args[0], strings.Join(config.Keys, ", "))
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := settings.Get(key)
+	if !printer.IsTable() {
+		return printer.Print(output.Setting{Key: s.Key, Value: s.Value, Source: string(s.Source)})
+	}
+
+	if s.Value != "" {
+		fmt.Println(s.Value)
+	}
+	return nil
+}
+
+func init() {
+	configCmd.AddCommand(configGetCmd)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/config"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// configListCmd represents the config list command
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Used to list the settings in effect and where they come from",
+	Args:  cobra.NoArgs,
+	RunE:  listConfig,
+}
+
+func listConfig(cmd *cobra.Command, args []string) error {
+	if settingsErr != nil {
+		return settingsErr
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	all := settings.All()
+
+	if !printer.IsTable() {
+		items := make([]output.Setting, len(all))
+		for i, s := range all {
+			items[i] = output.Setting{Key: s.Key, Value: s.Value, Source: string(s.Source)}
+		}
+		return printer.PrintList(items)
+	}
+
+	ui.Header("Config")
+	ui.Field("Profile", fmt.Sprintf("%s %s", ui.HighlightString(settings.Profile), ui.SubtleString("("+string(settings.ProfileSource)+")")))
+	if names := cmsConfig.ProfileNames(); len(names) > 0 {
+		ui.Field("Profiles", strings.Join(names, ", "))
+	}
+	ui.Field("Global config", configFileStatus(cmsConfig.Global))
+	if cmsConfig.Project != nil {
+		ui.Field("Project config", configFileStatus(cmsConfig.Project))
+	}
+	fmt.Printf("\n")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("KEY\tVALUE\tSOURCE"))
+	fmt.Fprintln(w, ui.SubtleString("---\t-----\t------"))
+
+	for _, s := range all {
+		value := s.Value
+		if value == "" {
+			value = ui.SubtleString("(not set)")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", s.Key, value, ui.SubtleString(string(s.Source)))
+	}
+
+	return w.Flush()
+}
+
+// configFileStatus describes a config file's path and whether it exists
+func configFileStatus(file *config.File) string {
+	if _, err := os.Stat(file.Path()); err != nil {
+		return ui.LinkString(file.Path()) + ui.SubtleString(" (not created yet)")
+	}
+	return ui.LinkString(file.Path())
+}
+
+func init() {
+	configCmd.AddCommand(configListCmd)
+}
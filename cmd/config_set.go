@@ -0,0 +1,90 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/config"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// configSetCmd represents the config set command
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Used to change a setting in a config profile",
+	Long: `Change a setting in the current profile, or the one given by --profile, creating
+the profile if needed. The global config file is changed unless --local is
+given. An empty value unsets the setting.
+
+Examples:
+  cms config set database_url ~/blog/cms.db
+  cms config set author "Jane Doe" --profile work
+  cms config set database_url ./cms.db --local
+  cms config set editor ""`,
+	Args: cobra.ExactArgs(2),
+	RunE: setConfig,
+}
+
+func setConfig(cmd *cobra.Command, args []string) error {
+	key, value := configKey(args[0]), args[1]
+
+	if value != "" {
+		switch key {
+		case config.KeyDatabaseURL:
+			if _, _, err := database.ParseURL(value); err != nil {
+				return err
+			}
+		case config.KeyOutput:
+			if _, _, err := output.ParseFormat(value); err != nil {
+				return err
+			}
+		}
+	}
+
+	name, err := cmd.Flags().GetString(profileFlagName)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		name = config.DefaultProfile
+		if settings != nil {
+			name = settings.Profile
+		}
+	}
+
+	file, err := configFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	profile, _ := file.Profile(name)
+	if err := profile.Set(key, value); err != nil {
+		return err
+	}
+	file.SetProfile(name, profile)
+
+	if err := file.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	if value == "" {
+		ui.PrintSuccess("Unset %s in profile '%s'\n", key, name)
+	} else {
+		ui.PrintSuccess("Set %s in profile '%s'\n", key, name)
+	}
+	ui.Field("Config", ui.LinkString(file.Path()))
+
+	return nil
+}
+
+func init() {
+	configCmd.AddCommand(configSetCmd)
+
+	configSetCmd.Flags().Bool(localFlagName, false, "Change the project's .cms.yaml instead of the global config")
+}
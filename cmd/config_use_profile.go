@@ -0,0 +1,57 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/config"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// configUseProfileCmd represents the config use-profile command
+var configUseProfileCmd = &cobra.Command{
+	Use:   "use-profile <name>",
+	Short: "Used to choose the current config profile",
+	Long: `Make a profile the current one, in the global config file or with --local in
+the project's .cms.yaml. A project's current profile takes precedence over the
+global one.`,
+	Args: cobra.ExactArgs(1),
+	RunE: useProfile,
+}
+
+func useProfile(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	if name != config.DefaultProfile && !cmsConfig.HasProfile(name) {
+		return fmt.Errorf("profile %q not found, create it with: cms config set <key> <value> --profile %s", name, name)
+	}
+
+	file, err := configFile(cmd)
+	if err != nil {
+		return err
+	}
+
+	file.CurrentProfile = name
+	if err := file.Save(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	ui.PrintSuccess("Now using profile '%s'\n", name)
+	ui.Field("Config", ui.LinkString(file.Path()))
+
+	// A project's current profile wins over the global one
+	if file == cmsConfig.Global && cmsConfig.Project != nil && cmsConfig.Project.CurrentProfile != "" && cmsConfig.Project.CurrentProfile != name {
+		ui.PrintWarning("%s uses profile '%s' here. Use --local to change it.\n", cmsConfig.Project.Path(), cmsConfig.Project.CurrentProfile)
+	}
+
+	return nil
+}
+
+func init() {
+	configCmd.AddCommand(configUseProfileCmd)
+
+	configUseProfileCmd.Flags().Bool(localFlagName, false, "Change the project's .cms.yaml instead of the global config")
+}
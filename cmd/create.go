@@ -9,7 +9,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dreamsofcode-io/cli-cms/internal/config"
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/editor"
 	"github.com/dreamsofcode-io/cli-cms/internal/forms"
 	"github.com/dreamsofcode-io/cli-cms/internal/handler"
 	"github.com/dreamsofcode-io/cli-cms/internal/ui"
@@ -22,6 +24,7 @@ const (
 	authorFlagName  = "author"
 	slugFlagName    = "slug"
 	editorFlagName  = "editor"
+	tagFlagName     = "tag"
 )
 
 // createCmd represents the create command
@@ -34,6 +37,9 @@ var createCmd = &cobra.Command{
 Examples:
   # Create post with flags
   cms posts create --title "My Post" --content "Content here" --author "John"
+
+  # Create post with tags
+  cms posts create --title "My Post" --tag go --tag cli
   
   # Create post interactively
   cms posts create --interactive
@@ -84,12 +90,20 @@ func createPost(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if !cmd.Flags().Changed(authorFlagName) {
+		author = setting(config.KeyAuthor)
+	}
 
 	slug, err := cmd.Flags().GetString(slugFlagName)
 	if err != nil {
 		return err
 	}
 
+	tags, err := cmd.Flags().GetStringSlice(tagFlagName)
+	if err != nil {
+		return err
+	}
+
 	// Check if we should use editor or content flag
 	useEditor, err := cmd.Flags().GetBool(editorFlagName)
 	if err != nil {
@@ -97,11 +111,20 @@ func createPost(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get database connection
-	db, err := database.GetDatabase(ctx, databaseURL)
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	author, err = resolveAuthor(ctx, cmd, db, author)
+	if errors.Is(err, forms.ErrUserCancelled) {
+		ui.PrintWarning("Post creation cancelled.\n")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
 	if verbose {
 		ui.PrintInfo("Database URL: %s\n", databaseURL)
 		ui.PrintInfo("Creating new post...\n")
@@ -118,7 +141,14 @@ func createPost(cmd *cobra.Command, args []string) error {
 			ui.PrintInfo("Opening editor for content input...\n")
 		}
 
-		createdPost, err = postsHandler.CreatePost(ctx, title, author, slug, true)
+		// Tags given on the command line prefill the template
+		createdPost, err = postsHandler.CreatePostInEditor(ctx, editor.Post{
+			Title:  title,
+			Author: author,
+			Slug:   slug,
+			Tags:   tags,
+			Status: string(database.StatusDraft),
+		})
 		if err != nil {
 			return err
 		}
@@ -129,12 +159,27 @@ func createPost(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		createdPost, err = postsHandler.CreatePostWithContent(ctx, title, content, author, slug)
+		// The post and its tags are created together
+		createdPost, _, err = db.CreatePostWithTags(ctx, database.CreatePostFromInput(title, content, author, slug), tags)
 		if err != nil {
-			return err
+			return fmt.Errorf("failed to create post: %w", err)
 		}
 	}
 
+	postTags, err := db.GetPostTags(ctx, int(createdPost.ID))
+	if err != nil {
+		return fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !printer.IsTable() {
+		return printPost(ctx, db, printer, createdPost)
+	}
+
 	// Display the created post information
 	ui.PrintSuccess("Post created successfully!\n")
 	ui.Field("ID", createdPost.ID)
@@ -148,6 +193,9 @@ func createPost(cmd *cobra.Command, args []string) error {
 	if createdPost.Slug.Valid {
 		ui.Field("Slug", ui.LinkString(createdPost.Slug.String))
 	}
+	if len(postTags) > 0 {
+		ui.Field("Tags", formatTags(postTags))
+	}
 	if createdPost.CreatedAt.Valid {
 		ui.Field("Created", createdPost.CreatedAt.Time.Format("2006-01-02 15:04:05"))
 	}
@@ -178,10 +226,15 @@ func createPostInteractive(cmd *cobra.Command, ctx context.Context) error {
 	}
 	if cmd.Flags().Changed(authorFlagName) {
 		initialData.Author, _ = cmd.Flags().GetString(authorFlagName)
+	} else {
+		initialData.Author = setting(config.KeyAuthor)
 	}
 	if cmd.Flags().Changed(slugFlagName) {
 		initialData.Slug, _ = cmd.Flags().GetString(slugFlagName)
 	}
+	if cmd.Flags().Changed(tagFlagName) {
+		initialData.Tags, _ = cmd.Flags().GetStringSlice(tagFlagName)
+	}
 
 	// Determine if we should use editor (default to true for better experience)
 	useEditor := true
@@ -210,7 +263,7 @@ func createPostInteractive(cmd *cobra.Command, ctx context.Context) error {
 	}
 
 	// Get database connection
-	db, err := database.GetDatabase(ctx, databaseURL)
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -220,11 +273,33 @@ func createPostInteractive(cmd *cobra.Command, ctx context.Context) error {
 		ui.PrintInfo("Creating new post...\n")
 	}
 
-	// Convert form data to post and create
-	post := formData.ToPost()
-	createdPost, err := db.CreatePost(ctx, post)
+	formData.Author, err = resolveAuthor(ctx, cmd, db, formData.Author)
+	if errors.Is(err, forms.ErrUserCancelled) {
+		ui.PrintWarning("Post creation cancelled.\n")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Create the post with the tags and status set in the editor
+	createdPost, err := handler.NewPosts(db).CreatePostFromForm(ctx, formData)
+	if err != nil {
+		return err
+	}
+
+	tags, err := db.GetPostTags(ctx, int(createdPost.ID))
+	if err != nil {
+		return fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	printer, err := newPrinter(cmd)
 	if err != nil {
-		return fmt.Errorf("failed to create post: %w", err)
+		return err
+	}
+
+	if !printer.IsTable() {
+		return printPost(ctx, db, printer, createdPost)
 	}
 
 	// Display the created post information
@@ -240,6 +315,9 @@ func createPostInteractive(cmd *cobra.Command, ctx context.Context) error {
 	if createdPost.Slug.Valid {
 		ui.Field("Slug", ui.LinkString(createdPost.Slug.String))
 	}
+	if len(tags) > 0 {
+		ui.Field("Tags", formatTags(tags))
+	}
 	if createdPost.CreatedAt.Valid {
 		ui.Field("Created", createdPost.CreatedAt.Time.Format("2006-01-02 15:04:05"))
 	}
@@ -253,7 +331,8 @@ func init() {
 	// Add flags for post creation
 	createCmd.Flags().StringP(titleFlagName, "t", "", "Title of the post (required unless using --interactive)")
 	createCmd.Flags().StringP(contentFlagName, "c", "", "Content of the post (ignored if --editor is used)")
-	createCmd.Flags().StringP(authorFlagName, "a", "", "Author of the post")
-	createCmd.Flags().StringP(slugFlagName, "s", "", "URL slug for the post")
+	createCmd.Flags().StringP(authorFlagName, "a", "", "Author of the post (defaults to the author setting of the config profile)")
+	createCmd.Flags().StringP(slugFlagName, "s", "", "URL slug for the post (made from the title if not set)")
 	createCmd.Flags().BoolP(editorFlagName, "e", false, "Open editor for content input (ignored in interactive mode)")
+	createCmd.Flags().StringSlice(tagFlagName, nil, "Tag to attach to the post (repeatable or comma-separated)")
 }
\ No newline at end of file
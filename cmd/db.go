@@ -0,0 +1,19 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// dbCmd represents the db command
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Used to manage the database",
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+}
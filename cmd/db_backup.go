@@ -0,0 +1,79 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/backup"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	gzipFlagName = "gzip"
+)
+
+// dbBackupCmd represents the db backup command
+var dbBackupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Used to back up the database",
+	Long: `Back up the SQLite database to a file. The backup is consistent even while
+other commands or "cms serve" are using the database.
+
+A manifest with the backup's size, SHA-256 checksum and schema version is
+written next to it as <file>.manifest.json, and is checked by "cms db restore".`,
+	Args: cobra.NoArgs,
+	RunE: backupDatabase,
+}
+
+func backupDatabase(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	out, err := cmd.Flags().GetString(outFlagName)
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		return errors.New("--out must be set to the backup file")
+	}
+
+	compress, err := cmd.Flags().GetBool(gzipFlagName)
+	if err != nil {
+		return err
+	}
+	if compress && !strings.HasSuffix(out, ".gz") {
+		out += ".gz"
+	}
+
+	manifest, err := backup.Create(ctx, databaseURL, out, compress)
+	if err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+
+	ui.PrintSuccess("Database backed up to %s\n", out)
+	ui.Field("Size", fmt.Sprintf("%d bytes", manifest.Size))
+	ui.Field("SHA-256", manifest.SHA256)
+	ui.Field("Schema", manifest.SchemaVersion)
+	ui.Field("Manifest", backup.ManifestPath(out))
+
+	return nil
+}
+
+func init() {
+	dbCmd.AddCommand(dbBackupCmd)
+
+	dbBackupCmd.Flags().String(outFlagName, "", "File to write the backup to")
+	dbBackupCmd.Flags().Bool(gzipFlagName, false, "Compress the backup with gzip, adding .gz to the file name")
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// dbMigrateCmd represents the db migrate command
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Used to manage the database schema migrations",
+	Long: `Used to manage the database schema migrations.
+
+The migrations are built into cms. Other commands apply any pending
+migrations when they open the database, unless --no-auto-migrate is set.`,
+}
+
+func init() {
+	dbCmd.AddCommand(dbMigrateCmd)
+}
+
+// openMigrator creates a migrator for the database given by the global
+// --database-url flag
+func openMigrator(cmd *cobra.Command) (*database.Migrator, error) {
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator, err := database.NewMigrator(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return migrator, nil
+}
+
+// parseSteps reads the optional number of migrations argument
+func parseSteps(args []string, defaultSteps int) (int, error) {
+	if len(args) == 0 {
+		return defaultSteps, nil
+	}
+
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps <= 0 {
+		return 0, fmt.Errorf("invalid number of migrations %q (must be a positive integer)", args[0])
+	}
+
+	return steps, nil
+}
+
+// migrationFailed explains how to recover when a migration left the schema dirty
+func migrationFailed(err error) error {
+	if errors.Is(err, database.ErrDirtySchema) {
+		ui.PrintWarning("A migration failed part way through. Fix the schema by hand, then run\n")
+		ui.PrintWarning("\"cms db migrate force <version>\" with the last version that was fully applied\n")
+	}
+	return err
+}
+
+// printMigrationVersion shows the schema version after a migration
+func printMigrationVersion(migrator *database.Migrator) error {
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	ui.Field("Version", fmt.Sprintf("%d of %d", version, migrator.Latest()))
+	if dirty {
+		ui.Field("Dirty", "yes")
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// dbMigrateDownCmd represents the db migrate down command
+var dbMigrateDownCmd = &cobra.Command{
+	Use:   "down [N]",
+	Short: "Used to roll back the last N migrations (default 1)",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  migrateDown,
+}
+
+func migrateDown(cmd *cobra.Command, args []string) error {
+	steps, err := parseSteps(args, 1)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := openMigrator(cmd)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	before, _, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	if err := migrator.Down(steps); err != nil {
+		return migrationFailed(err)
+	}
+
+	after, _, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	if after == before {
+		ui.PrintInfo("No migrations to roll back\n")
+	} else {
+		ui.PrintSuccess("Rolled back %d migration(s)\n", before-after)
+	}
+
+	return printMigrationVersion(migrator)
+}
+
+func init() {
+	dbMigrateCmd.AddCommand(dbMigrateDownCmd)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// dbMigrateForceCmd represents the db migrate force command
+var dbMigrateForceCmd = &cobra.Command{
+	Use:   "force V",
+	Short: "Used to set the schema version without running migrations",
+	Long: `Used to set the schema version without running migrations.
+
+When a migration fails part way through, the database is marked dirty and
+no further migrations run. Fix the schema by hand, then force the version
+of the last migration that was fully applied to clear the dirty flag.
+Version 0 means no migrations have been applied.`,
+	Args: cobra.ExactArgs(1),
+	RunE: migrateForce,
+}
+
+func migrateForce(cmd *cobra.Command, args []string) error {
+	version, err := strconv.ParseUint(args[0], 10, 0)
+	if err != nil {
+		return fmt.Errorf("invalid version %q (must be a non-negative integer)", args[0])
+	}
+
+	migrator, err := openMigrator(cmd)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	if err := migrator.Force(uint(version)); err != nil {
+		return err
+	}
+
+	ui.PrintSuccess("Schema version set to %d\n", version)
+	return nil
+}
+
+func init() {
+	dbMigrateCmd.AddCommand(dbMigrateForceCmd)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// dbMigrateStatusCmd represents the db migrate status command
+var dbMigrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Used to list the applied and pending migrations",
+	Args:  cobra.NoArgs,
+	RunE:  migrateStatus,
+}
+
+func migrateStatus(cmd *cobra.Command, args []string) error {
+	migrator, err := openMigrator(cmd)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !printer.IsTable() {
+		return printer.PrintList(status.Migrations)
+	}
+
+	ui.Header("Migrations")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("VERSION\tNAME\tSTATUS"))
+	fmt.Fprintln(w, ui.SubtleString("-------\t----\t------"))
+
+	for _, migration := range status.Migrations {
+		state := ui.SuccessString("applied")
+		switch {
+		case migration.Version == status.Version && status.Dirty:
+			state = ui.ErrorString("dirty")
+		case !migration.Applied:
+			state = ui.WarningString("pending")
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\n", migration.Version, migration.Name, state)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+
+	ui.Field("Version", status.Version)
+	if status.Dirty {
+		ui.PrintWarning("Migration %d failed part way through, see \"cms db migrate force --help\"\n", status.Version)
+		return nil
+	}
+
+	if pending := len(status.Pending()); pending > 0 {
+		ui.PrintInfo("%d pending migration(s), run \"cms db migrate up\" to apply them\n", pending)
+	} else {
+		ui.PrintSuccess("Database is up to date\n")
+	}
+
+	return nil
+}
+
+func init() {
+	dbMigrateCmd.AddCommand(dbMigrateStatusCmd)
+}
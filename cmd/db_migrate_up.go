@@ -0,0 +1,57 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// dbMigrateUpCmd represents the db migrate up command
+var dbMigrateUpCmd = &cobra.Command{
+	Use:   "up [N]",
+	Short: "Used to apply pending migrations, all of them unless N is given",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  migrateUp,
+}
+
+func migrateUp(cmd *cobra.Command, args []string) error {
+	steps, err := parseSteps(args, 0)
+	if err != nil {
+		return err
+	}
+
+	migrator, err := openMigrator(cmd)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	before, _, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	if err := migrator.Up(steps); err != nil {
+		return migrationFailed(err)
+	}
+
+	after, _, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	if after == before {
+		ui.PrintInfo("Database is already up to date\n")
+	} else {
+		ui.PrintSuccess("Applied %d migration(s)\n", after-before)
+	}
+
+	return printMigrationVersion(migrator)
+}
+
+func init() {
+	dbMigrateCmd.AddCommand(dbMigrateUpCmd)
+}
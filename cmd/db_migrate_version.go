@@ -0,0 +1,44 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// dbMigrateVersionCmd represents the db migrate version command
+var dbMigrateVersionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Used to print the current schema version",
+	Args:  cobra.NoArgs,
+	RunE:  migrateVersion,
+}
+
+func migrateVersion(cmd *cobra.Command, args []string) error {
+	migrator, err := openMigrator(cmd)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		fmt.Printf("%d (dirty)\n", version)
+	} else {
+		fmt.Printf("%d\n", version)
+	}
+
+	return nil
+}
+
+func init() {
+	dbMigrateCmd.AddCommand(dbMigrateVersionCmd)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/backup"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// dbRestoreCmd represents the db restore command
+var dbRestoreCmd = &cobra.Command{
+	Use:   "restore FILE",
+	Short: "Used to replace the database with a backup",
+	Long: `Replace the SQLite database with a backup made by "cms db backup".
+
+The backup is checked against its manifest, if there is one, and its schema
+version against the migrations built into cms before the database is
+replaced. The replaced database is kept with a .before-restore suffix, or
+.before-restore-2 and so on if an earlier restore kept one. Stop "cms serve"
+before restoring.`,
+	Args: cobra.ExactArgs(1),
+	RunE: restoreDatabase,
+}
+
+func restoreDatabase(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	result, err := backup.Restore(ctx, databaseURL, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to restore database: %w", err)
+	}
+
+	if result.Manifest == nil {
+		ui.PrintWarning("No manifest found for %s, the checksum wasn't verified\n", args[0])
+	}
+
+	ui.PrintSuccess("Database restored from %s\n", args[0])
+	ui.Field("Schema", result.SchemaVersion)
+	if result.Previous != "" {
+		ui.Field("Previous", result.Previous)
+	}
+
+	return nil
+}
+
+func init() {
+	dbCmd.AddCommand(dbRestoreCmd)
+}
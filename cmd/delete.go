@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/forms"
 	"github.com/dreamsofcode-io/cli-cms/internal/ui"
 	"github.com/spf13/cobra"
 )
@@ -22,8 +23,15 @@ const (
 var deleteCmd = &cobra.Command{
 	Use:     "delete",
 	Aliases: []string{"remove"},
-	Short:   "Used to delete a post",
-	RunE:    deletePost,
+	Short:   "Used to move a post to the trash",
+	Long: `Move a post to the trash. Trashed posts are hidden from every other command
+and can be brought back with "cms trash restore" or removed for good with
+"cms trash purge".
+
+When run in a terminal, the post is shown and you are asked to confirm unless
+--force is set. With --interactive and no --id or --slug, pick any number of
+posts to delete from a list.`,
+	RunE: deletePost,
 }
 
 func deletePost(cmd *cobra.Command, args []string) error {
@@ -33,8 +41,14 @@ func deletePost(cmd *cobra.Command, args []string) error {
 	idSet := cmd.Flags().Changed(idFlagName)
 	slugSet := cmd.Flags().Changed(slugFlagName)
 
-	if !idSet && !slugSet {
-		return errors.New("either --id or --slug flag must be set")
+	// Get interactive flag
+	interactive, err := cmd.Flags().GetBool(interactiveFlagName)
+	if err != nil {
+		return err
+	}
+
+	if !idSet && !slugSet && !interactive {
+		return errors.New("either --id or --slug flag must be set, or --interactive to pick posts")
 	}
 
 	if idSet && slugSet {
@@ -60,11 +74,34 @@ func deletePost(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get database connection
-	db, err := database.GetDatabase(ctx, databaseURL)
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if !idSet && !slugSet {
+		return deletePostsInteractive(ctx, db, force)
+	}
+
+	// Ask for confirmation when someone is there to answer
+	if !force && (interactive || ui.IsTerminal()) {
+		post, err := lookupPost(ctx, cmd, db)
+		if err != nil {
+			return fmt.Errorf("failed to get post: %w", err)
+		}
+
+		err = forms.ConfirmDelete(post)
+		if errors.Is(err, forms.ErrUserCancelled) {
+			ui.PrintWarning("Post deletion cancelled.\n")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("interactive mode requires a TTY. Please use --force instead: %w", err)
+		}
+
+		force = true
+	}
+
 	if idSet {
 		id, err := cmd.Flags().GetInt(idFlagName)
 		if err != nil {
@@ -85,7 +122,7 @@ func deletePost(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to delete post: %w", err)
 		}
 
-		ui.PrintSuccess("Post with ID %d deleted successfully!\n", id)
+		ui.PrintSuccess("Post with ID %d moved to the trash!\n", id)
 	}
 
 	if slugSet {
@@ -108,9 +145,41 @@ func deletePost(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("failed to delete post: %w", err)
 		}
 
-		ui.PrintSuccess("Post with slug '%s' deleted successfully!\n", slug)
+		ui.PrintSuccess("Post with slug '%s' moved to the trash!\n", slug)
+	}
+
+	return nil
+}
+
+// deletePostsInteractive moves the posts picked from a list to the trash
+func deletePostsInteractive(ctx context.Context, db database.Storage, force bool) error {
+	posts, err := db.ListPosts(ctx, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to list posts: %w", err)
+	}
+
+	if len(posts) == 0 {
+		ui.PrintInfo("No posts to delete.\n")
+		return nil
+	}
+
+	selected, err := forms.SelectPostsToDelete(posts, !force)
+	if errors.Is(err, forms.ErrUserCancelled) {
+		ui.PrintWarning("Post deletion cancelled.\n")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("interactive mode requires a TTY. Please use --id or --slug instead: %w", err)
+	}
+
+	for _, post := range selected {
+		if err := db.DeletePostByID(ctx, int(post.ID)); err != nil {
+			return fmt.Errorf("failed to delete post %d: %w", post.ID, err)
+		}
+		ui.PrintSuccess("Post %q moved to the trash!\n", post.Title)
 	}
 
+	ui.PrintInfo("Moved %d post(s) to the trash\n", len(selected))
 	return nil
 }
 
@@ -118,7 +187,7 @@ func init() {
 	postsCmd.AddCommand(deleteCmd)
 
 	// Add flags for post deletion
-	deleteCmd.Flags().IntP(idFlagName, "i", 0, "ID of the post to delete")
+	deleteCmd.Flags().Int(idFlagName, 0, "ID of the post to delete")
 	deleteCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to delete")
 	deleteCmd.Flags().BoolP(forceFlagName, "f", false, "Force delete without confirmation")
 }
\ No newline at end of file
@@ -0,0 +1,144 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+)
+
+const (
+	revFlagName = "rev"
+)
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Used to compare revisions of a post",
+	Long: `Show a unified diff of the title, author, slug and content of two revisions.
+With a single --rev the revision is compared against the current post.
+
+Examples:
+  # Compare revision 2 with revision 3
+  cms posts diff --slug my-post --rev 2 --rev 3
+
+  # Compare revision 2 with the current post
+  cms posts diff --slug my-post --rev 2`,
+	RunE: diffPost,
+}
+
+func diffPost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	revs, err := cmd.Flags().GetIntSlice(revFlagName)
+	if err != nil {
+		return err
+	}
+
+	if len(revs) == 0 || len(revs) > 2 {
+		return errors.New("--rev must be given once or twice")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	from, err := db.GetPostRevision(ctx, int(post.ID), revs[0])
+	if err != nil {
+		return fmt.Errorf("failed to get revision %d: %w", revs[0], err)
+	}
+	fromName := fmt.Sprintf("rev %d", from.Revision)
+	fromText := revisionText(from.Title, from.Author, from.Slug, from.Content)
+
+	toName := "current"
+	toText := revisionText(post.Title, post.Author, post.Slug, post.Content)
+	if len(revs) == 2 {
+		to, err := db.GetPostRevision(ctx, int(post.ID), revs[1])
+		if err != nil {
+			return fmt.Errorf("failed to get revision %d: %w", revs[1], err)
+		}
+		toName = fmt.Sprintf("rev %d", to.Revision)
+		toText = revisionText(to.Title, to.Author, to.Slug, to.Content)
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(fromText),
+		B:        difflib.SplitLines(toText),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute diff: %w", err)
+	}
+
+	if diff == "" {
+		ui.PrintInfo("No differences between %s and %s\n", fromName, toName)
+		return nil
+	}
+
+	printDiff(diff)
+
+	return nil
+}
+
+// revisionText renders a version of a post as text for diffing
+func revisionText(title string, author, slug, content sql.NullString) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Title: %s\n", title)
+	fmt.Fprintf(&b, "Author: %s\n", database.NullStringToString(author))
+	fmt.Fprintf(&b, "Slug: %s\n", database.NullStringToString(slug))
+	b.WriteString("\n")
+	b.WriteString(database.NullStringToString(content))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// printDiff prints a unified diff with added and removed lines colored
+func printDiff(diff string) {
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			fmt.Print(ui.HighlightString("%s", line))
+		case strings.HasPrefix(line, "@@"):
+			fmt.Print(ui.InfoString("%s", line))
+		case strings.HasPrefix(line, "+"):
+			fmt.Print(ui.SuccessString("%s", line))
+		case strings.HasPrefix(line, "-"):
+			fmt.Print(ui.ErrorString("%s", line))
+		default:
+			fmt.Print(line)
+		}
+	}
+}
+
+func init() {
+	postsCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().Int(idFlagName, 0, "ID of the post")
+	diffCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post")
+	diffCmd.Flags().IntSlice(revFlagName, nil, "Revision to compare (give twice to compare two revisions)")
+}
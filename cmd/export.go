@@ -0,0 +1,78 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/content"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dirFlagName = "dir"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Used to export posts as Markdown files",
+	Long: `Export every post to a directory as <slug>.md, with the title, author, slug,
+status, tags and timestamps stored in YAML frontmatter.
+
+Examples:
+  cms export --dir ./content`,
+	RunE: exportPosts,
+}
+
+func exportPosts(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	dir, err := cmd.Flags().GetString(dirFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	results, err := content.Export(ctx, db, dir)
+	if err != nil {
+		return fmt.Errorf("failed to export posts: %w", err)
+	}
+
+	written := 0
+	for _, result := range results {
+		if result.Action == content.ActionSkipped {
+			ui.PrintWarning("Skipped: %s\n", result.Reason)
+			continue
+		}
+		fmt.Printf("  %s %s\n", ui.SuccessString("wrote"), ui.LinkString(result.Path))
+		written++
+	}
+
+	fmt.Printf("\n")
+	ui.PrintSuccess("Exported %d post(s) to %s\n", written, dir)
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().String(dirFlagName, "./content", "Directory to write the Markdown files to")
+}
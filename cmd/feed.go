@@ -0,0 +1,159 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/feed"
+	"github.com/dreamsofcode-io/cli-cms/internal/site"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	formatFlagName      = "format"
+	descriptionFlagName = "description"
+)
+
+// feedCmd represents the feed command
+var feedCmd = &cobra.Command{
+	Use:   "feed",
+	Short: "Used to generate an RSS, Atom or JSON feed of the latest posts",
+	Long: `Generate a feed of the latest published posts. Item links point at the pages
+written by "cms build", so use the same --base-url for both.
+
+Without --out the feed is written to stdout. When --out is a directory the
+feed is written into it as rss.xml, atom.xml or feed.json, which makes it easy
+to add to a "cms build" output directory.
+
+Examples:
+  cms feed --format rss --base-url https://blog.example.com/ > rss.xml
+  cms feed --format atom --base-url https://blog.example.com/ --out ./public
+  cms feed --format json --base-url https://blog.example.com/ --limit 50 --out ./public`,
+	RunE: generateFeed,
+}
+
+func generateFeed(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	formatValue, err := cmd.Flags().GetString(formatFlagName)
+	if err != nil {
+		return err
+	}
+
+	format, err := feed.ParseFormat(formatValue)
+	if err != nil {
+		return err
+	}
+
+	baseURL, err := cmd.Flags().GetString(baseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	if parsed, err := url.Parse(baseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("--base-url must be an absolute http(s) URL, got %q", baseURL)
+	}
+
+	limit, err := cmd.Flags().GetInt(limitFlagName)
+	if err != nil {
+		return err
+	}
+
+	out, err := cmd.Flags().GetString(outFlagName)
+	if err != nil {
+		return err
+	}
+
+	title, err := cmd.Flags().GetString(siteTitleFlagName)
+	if err != nil {
+		return err
+	}
+
+	description, err := cmd.Flags().GetString(descriptionFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	posts, _, err := site.LoadPosts(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	if limit > 0 && len(posts) > limit {
+		posts = posts[:limit]
+	}
+
+	cfg := feed.Config{
+		Title:       title,
+		Description: description,
+		BaseURL:     baseURL,
+	}
+
+	if out == "" || out == "-" {
+		return feed.Write(os.Stdout, format, cfg, posts)
+	}
+
+	path := out
+	if ext := filepath.Ext(out); ext != ".xml" && ext != ".json" {
+		if err := os.MkdirAll(out, 0755); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+		path = filepath.Join(out, format.Filename())
+	}
+
+	if err := writeFeedFile(path, format, cfg, posts); err != nil {
+		return fmt.Errorf("failed to write feed: %w", err)
+	}
+
+	ui.PrintSuccess("Wrote %s feed with %d post(s) to %s\n", format, len(posts), ui.LinkString(path))
+
+	return nil
+}
+
+// writeFeedFile writes a feed to a file, replacing any existing one
+func writeFeedFile(path string, format feed.Format, cfg feed.Config, posts []site.Post) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := feed.Write(file, format, cfg, posts); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func init() {
+	rootCmd.AddCommand(feedCmd)
+
+	feedCmd.Flags().String(formatFlagName, string(feed.FormatRSS), "Feed format: rss, atom or json")
+	feedCmd.Flags().String(baseURLFlagName, "", "Absolute URL of the site, e.g. https://blog.example.com/ (required)")
+	feedCmd.Flags().IntP(limitFlagName, "l", 20, "Number of latest posts to include (0 for all)")
+	feedCmd.Flags().String(outFlagName, "", "File or directory to write the feed to (default stdout)")
+	feedCmd.Flags().String(siteTitleFlagName, "My Blog", "Title of the feed")
+	feedCmd.Flags().String(descriptionFlagName, "", "Description of the feed")
+	feedCmd.MarkFlagRequired(baseURLFlagName)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
 	"github.com/dreamsofcode-io/cli-cms/internal/ui"
@@ -15,7 +16,8 @@ import (
 )
 
 const (
-	idFlagName = "id"
+	idFlagName  = "id"
+	rawFlagName = "raw"
 )
 
 // getCmd represents the get command
@@ -52,8 +54,13 @@ func getPost(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Get database connection
-	db, err := database.GetDatabase(ctx, databaseURL)
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -86,39 +93,86 @@ func getPost(cmd *cobra.Command, args []string) error {
 			ui.PrintInfo("Getting post with slug: %s\n", slug)
 		}
 
-		post, err = db.GetPostBySlug(ctx, slug)
+		post, err = getPostBySlug(ctx, cmd, db, slug)
 		if err != nil {
 			return fmt.Errorf("failed to get post: %w", err)
 		}
 	}
 
+	if !printer.IsTable() {
+		return printPost(ctx, db, printer, post)
+	}
+
+	raw, err := cmd.Flags().GetBool(rawFlagName)
+	if err != nil {
+		return err
+	}
+
+	tags, err := db.GetPostTags(ctx, int(post.ID))
+	if err != nil {
+		return fmt.Errorf("failed to get post tags: %w", err)
+	}
+
 	// Display the post
-	ui.Header("Post Details")
-	ui.Field("ID", post.ID)
-	ui.Field("Title", ui.HighlightString(post.Title))
-	if post.Content.Valid {
-		ui.Field("Content", post.Content.String)
+	var b strings.Builder
+	b.WriteString(ui.HeaderString("Post Details"))
+	b.WriteString(ui.FieldString("ID", post.ID))
+	b.WriteString(ui.FieldString("Title", ui.HighlightString(post.Title)))
+	if raw && post.Content.Valid {
+		b.WriteString(ui.FieldString("Content", post.Content.String))
 	}
 	if post.Author.Valid {
-		ui.Field("Author", post.Author.String)
+		b.WriteString(ui.FieldString("Author", post.Author.String))
 	}
 	if post.Slug.Valid {
-		ui.Field("Slug", ui.LinkString(post.Slug.String))
+		b.WriteString(ui.FieldString("Slug", ui.LinkString(post.Slug.String)))
+	}
+	b.WriteString(ui.FieldString("Status", post.Status))
+	if len(tags) > 0 {
+		b.WriteString(ui.FieldString("Tags", formatTags(tags)))
+	}
+	if post.PublishedAt.Valid {
+		b.WriteString(ui.FieldString("Published", post.PublishedAt.Time.Local().Format("2006-01-02 15:04:05")))
+	}
+	if post.ScheduledFor.Valid {
+		b.WriteString(ui.FieldString("Scheduled", post.ScheduledFor.Time.Local().Format("2006-01-02 15:04:05")))
 	}
 	if post.CreatedAt.Valid {
-		ui.Field("Created", post.CreatedAt.Time.Format("2006-01-02 15:04:05"))
+		b.WriteString(ui.FieldString("Created", post.CreatedAt.Time.Format("2006-01-02 15:04:05")))
 	}
 	if post.UpdatedAt.Valid {
-		ui.Field("Updated", post.UpdatedAt.Time.Format("2006-01-02 15:04:05"))
+		b.WriteString(ui.FieldString("Updated", post.UpdatedAt.Time.Format("2006-01-02 15:04:05")))
+	}
+
+	if raw {
+		fmt.Print(b.String())
+		return nil
+	}
+
+	// Render the content as Markdown below the details
+	if post.Content.Valid && post.Content.String != "" {
+		width, _, ok := ui.TerminalSize()
+		if !ok {
+			width = ui.DefaultWidth
+		}
+
+		content, err := ui.RenderMarkdown(post.Content.String, width)
+		if err != nil {
+			return fmt.Errorf("failed to render content: %w", err)
+		}
+
+		b.WriteString(ui.SubheaderString("Content"))
+		b.WriteString(content)
 	}
 
-	return nil
+	return ui.Page(b.String())
 }
 
 func init() {
 	postsCmd.AddCommand(getCmd)
 
 	// Add flags for post retrieval
-	getCmd.Flags().IntP(idFlagName, "i", 0, "ID of the post to retrieve")
+	getCmd.Flags().Int(idFlagName, 0, "ID of the post to retrieve")
 	getCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to retrieve")
+	getCmd.Flags().Bool(rawFlagName, false, "Show the content as stored instead of rendering it as Markdown, without a pager")
 }
\ No newline at end of file
@@ -0,0 +1,92 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Used to list the revision history of a post",
+	RunE:  postHistory,
+}
+
+func postHistory(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	revisions, err := db.ListPostRevisions(ctx, int(post.ID))
+	if err != nil {
+		return fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	ui.Header(fmt.Sprintf("History of \"%s\"", post.Title))
+
+	if len(revisions) == 0 {
+		fmt.Println("📝 No revisions found, this post has never been edited.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("REV\tTITLE\tAUTHOR\tSAVED"))
+	fmt.Fprintln(w, ui.SubtleString("---\t-----\t------\t-----"))
+
+	for _, rev := range revisions {
+		author := "(no author)"
+		if rev.Author.Valid {
+			author = rev.Author.String
+		}
+
+		saved := "(no date)"
+		if rev.CreatedAt.Valid {
+			saved = rev.CreatedAt.Time.Format("2006-01-02 15:04")
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			rev.Revision,
+			ui.HighlightString(rev.Title),
+			author,
+			ui.SubtleString(saved),
+		)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+	ui.PrintInfo("Found %d revision(s). Use 'cms posts diff' or 'cms posts restore' with --rev.\n", len(revisions))
+
+	return nil
+}
+
+func init() {
+	postsCmd.AddCommand(historyCmd)
+
+	historyCmd.Flags().Int(idFlagName, 0, "ID of the post")
+	historyCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post")
+}
@@ -0,0 +1,115 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/content"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dryRunFlagName = "dry-run"
+)
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Used to import posts from Markdown files",
+	Long: `Import Markdown files with YAML frontmatter, as written by "cms export".
+Posts are matched by slug: new slugs are created and existing posts are
+updated when anything changed. Files without a slug use their file name.
+
+Examples:
+  # Preview what would change
+  cms import --dir ./content --dry-run
+
+  # Apply the changes
+  cms import --dir ./content`,
+	RunE: importPosts,
+}
+
+func importPosts(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	dir, err := cmd.Flags().GetString(dirFlagName)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool(dryRunFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if dryRun {
+		ui.PrintInfo("Dry run, no changes will be made\n\n")
+	}
+
+	results, err := content.Import(ctx, db, dir, dryRun)
+	printImportResults(results)
+	if err != nil {
+		return fmt.Errorf("failed to import posts: %w", err)
+	}
+
+	counts := make(map[content.Action]int)
+	for _, result := range results {
+		counts[result.Action]++
+	}
+
+	fmt.Printf("\n")
+	summary := fmt.Sprintf("%d created, %d updated, %d unchanged\n",
+		counts[content.ActionCreated],
+		counts[content.ActionUpdated],
+		counts[content.ActionUnchanged],
+	)
+	if dryRun {
+		ui.PrintInfo("Would import: " + summary)
+	} else {
+		ui.PrintSuccess("Imported: " + summary)
+	}
+
+	return nil
+}
+
+// printImportResults lists each imported file with what happened to it
+func printImportResults(results []content.Result) {
+	for _, result := range results {
+		var action string
+		switch result.Action {
+		case content.ActionCreated:
+			action = ui.SuccessString("%-9s", result.Action)
+		case content.ActionUpdated:
+			action = ui.WarningString("%-9s", result.Action)
+		default:
+			action = ui.SubtleString("%-9s", result.Action)
+		}
+
+		fmt.Printf("  %s %s %s\n", action, ui.LinkString(result.Slug), ui.SubtleString("(%s)", result.Path))
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+
+	importCmd.Flags().String(dirFlagName, "./content", "Directory to read Markdown files from")
+	importCmd.Flags().Bool(dryRunFlagName, false, "Show what would be created, updated or left unchanged without writing")
+}
@@ -6,25 +6,48 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"text/tabwriter"
 	"os"
+	"time"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
 	"github.com/dreamsofcode-io/cli-cms/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 const (
-	limitFlagName  = "limit"
-	offsetFlagName = "offset"
+	limitFlagName         = "limit"
+	offsetFlagName        = "offset"
+	statusFlagName        = "status"
+	sinceFlagName         = "since"
+	untilFlagName         = "until"
+	titleContainsFlagName = "title-contains"
+	hasSlugFlagName       = "has-slug"
+	sortFlagName          = "sort"
 )
 
 // listCmd represents the list command
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Used to list all posts",
-	RunE:  listPosts,
+	Long: `List posts, newest first, optionally filtered and sorted.
+
+Filters can be combined, and only posts matching all of them are listed.
+--since and --until take a date, a time such as "2025-07-01 09:00", or an
+age such as 7d, 2w or 12h. A date given to --until includes the whole day.
+
+--sort takes a field and an optional direction, field[:asc|desc]. The
+fields are id, title, author, slug, status, created, updated and published.
+
+Examples:
+  cms posts list --author "Jane Doe" --status published
+  cms posts list --since 30d --sort title
+  cms posts list --title-contains go --tag golang --limit 0
+  cms posts list --has-slug=false --sort updated:desc`,
+	RunE: listPosts,
 }
 
 func listPosts(cmd *cobra.Command, args []string) error {
@@ -41,6 +64,11 @@ func listPosts(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
 	// Get local flags
 	limit, err := cmd.Flags().GetInt(limitFlagName)
 	if err != nil {
@@ -52,8 +80,76 @@ func listPosts(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	statusValue, err := cmd.Flags().GetString(statusFlagName)
+	if err != nil {
+		return err
+	}
+
+	tag, err := cmd.Flags().GetString(tagFlagName)
+	if err != nil {
+		return err
+	}
+
+	filter := database.PostFilter{
+		Tag:    tag,
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	if statusValue != "" {
+		filter.Status, err = database.ParsePostStatus(statusValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	filter.Author, err = cmd.Flags().GetString(authorFlagName)
+	if err != nil {
+		return err
+	}
+
+	filter.TitleContains, err = cmd.Flags().GetString(titleContainsFlagName)
+	if err != nil {
+		return err
+	}
+
+	if value, _ := cmd.Flags().GetString(sinceFlagName); value != "" {
+		filter.Since, err = parseListTime(value, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if value, _ := cmd.Flags().GetString(untilFlagName); value != "" {
+		filter.Until, err = parseListTime(value, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return errors.New("--since must be before --until")
+	}
+
+	// --has-slug only filters when it's given, as --has-slug=false lists the
+	// posts without a slug
+	if cmd.Flags().Changed(hasSlugFlagName) {
+		hasSlug, err := cmd.Flags().GetBool(hasSlugFlagName)
+		if err != nil {
+			return err
+		}
+		filter.HasSlug = &hasSlug
+	}
+
+	if value, _ := cmd.Flags().GetString(sortFlagName); value != "" {
+		filter.Sort, err = database.ParsePostSort(value)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Get database connection
-	db, err := database.GetDatabase(ctx, databaseURL)
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -64,12 +160,30 @@ func listPosts(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get posts from database
-	posts, err := db.ListPosts(ctx, limit, offset)
+	posts, total, err := db.ListPostsFiltered(ctx, filter)
 	if err != nil {
 		return fmt.Errorf("failed to list posts: %w", err)
 	}
 
+	if !printer.IsTable() {
+		records := make([]output.Post, len(posts))
+		for i, post := range posts {
+			records[i], err = postOutput(ctx, db, post)
+			if err != nil {
+				return err
+			}
+		}
+		if int64(len(posts)) < total {
+			fmt.Fprintln(cmd.ErrOrStderr(), ui.InfoString(pageSummary(offset, len(posts), total)))
+		}
+		return printer.PrintList(records)
+	}
+
 	if len(posts) == 0 {
+		if total > 0 {
+			fmt.Printf("📝 No posts found at offset %d (%d post(s) match).\n", offset, total)
+			return nil
+		}
 		fmt.Println("📝 No posts found.")
 		return nil
 	}
@@ -79,8 +193,8 @@ func listPosts(cmd *cobra.Command, args []string) error {
 	
 	// Use tabwriter for formatted output
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, ui.HighlightString("ID\tTITLE\tAUTHOR\tSLUG\tCREATED"))
-	fmt.Fprintln(w, ui.SubtleString("--\t-----\t------\t----\t-------"))
+	fmt.Fprintln(w, ui.HighlightString("ID\tTITLE\tAUTHOR\tSLUG\tSTATUS\tCREATED"))
+	fmt.Fprintln(w, ui.SubtleString("--\t-----\t------\t----\t------\t-------"))
 
 	for _, post := range posts {
 		author := "(no author)"
@@ -98,26 +212,68 @@ func listPosts(cmd *cobra.Command, args []string) error {
 			created = post.CreatedAt.Time.Format("2006-01-02 15:04")
 		}
 
-		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
 			post.ID,
 			ui.HighlightString(post.Title),
 			author,
 			ui.LinkString(slug),
+			post.Status,
 			ui.SubtleString(created),
 		)
 	}
 
 	w.Flush()
 	fmt.Printf("\n")
-	ui.PrintInfo("Found %d post(s)\n", len(posts))
+	if int64(len(posts)) < total {
+		ui.PrintInfo("%s\n", pageSummary(offset, len(posts), total))
+	} else {
+		ui.PrintInfo("Found %d post(s)\n", len(posts))
+	}
 
 	return nil
 }
 
+// pageSummary describes which of the matching posts a page shows, as in
+// "Showing 11-20 of 42 post(s)"
+func pageSummary(offset, count int, total int64) string {
+	return fmt.Sprintf("Showing %d-%d of %d post(s)", offset+1, offset+count, total)
+}
+
+// parseListTime parses the value of --since or --until, which is a time in one
+// of scheduleTimeLayouts or an age such as 7d, meaning that long ago. With
+// wholeDay set, a date on its own stands for the end of that day
+func parseListTime(value string, wholeDay bool) (time.Time, error) {
+	if day, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		if wholeDay {
+			return day.AddDate(0, 0, 1), nil
+		}
+		return day, nil
+	}
+
+	if age, err := parseAge(value); err == nil {
+		return time.Now().Add(-age), nil
+	}
+
+	for _, layout := range scheduleTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (use \"YYYY-MM-DD\", \"YYYY-MM-DD HH:MM\" or an age such as 7d)", value)
+}
+
 func init() {
 	postsCmd.AddCommand(listCmd)
 
 	// Add local flags for pagination
 	listCmd.Flags().IntP(limitFlagName, "l", 10, "Maximum number of posts to return")
 	listCmd.Flags().IntP(offsetFlagName, "o", 0, "Number of posts to skip")
+	listCmd.Flags().String(statusFlagName, "", "Only list posts with this status (draft, published, scheduled, archived)")
+	listCmd.Flags().String(tagFlagName, "", "Only list posts with this tag")
+	listCmd.Flags().String(authorFlagName, "", "Only list posts by this author")
+	listCmd.Flags().String(sinceFlagName, "", "Only list posts created at or after this time, or within this age (e.g. 7d)")
+	listCmd.Flags().String(untilFlagName, "", "Only list posts created before this time, or longer ago than this age (e.g. 7d)")
+	listCmd.Flags().String(titleContainsFlagName, "", "Only list posts whose title contains this text, ignoring case")
+	listCmd.Flags().Bool(hasSlugFlagName, false, "Only list posts with a slug, or without one with --has-slug=false")
+	listCmd.Flags().String(sortFlagName, database.DefaultPostSort.String(), "Sort by a field, as field[:asc|desc]")
 }
\ No newline at end of file
@@ -0,0 +1,87 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/spf13/cobra"
+)
+
+const (
+	mediaDirFlagName = "media-dir"
+)
+
+// mediaCmd represents the media command
+var mediaCmd = &cobra.Command{
+	Use:   "media",
+	Short: "Used to manage the media library",
+	Long: `Manage the images and other files posts refer to. Files are copied into a
+store named after the hash of their content, by default a media directory next
+to the SQLite database, and are only stored once however often they are added.
+
+Refer to a file from a post with its reference, which "cms media add" prints:
+
+  ![A diagram](media:3f2a9c1b7e4d)
+
+"cms build" copies the files posts refer to into the site and points the
+references at them.`,
+}
+
+func init() {
+	rootCmd.AddCommand(mediaCmd)
+
+	mediaCmd.PersistentFlags().String(mediaDirFlagName, "", "Directory of the media store (default: media next to the SQLite database)")
+}
+
+// mediaStore opens the media store given by --media-dir. It defaults to a
+// media directory next to a SQLite database, or in the working directory for
+// PostgreSQL
+func mediaStore(cmd *cobra.Command) (*media.Store, error) {
+	dir, err := cmd.Flags().GetString(mediaDirFlagName)
+	if err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		return media.NewStore(dir), nil
+	}
+
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, dsn, err := database.ParseURL(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if driver != database.DriverSQLite {
+		return media.NewStore("media"), nil
+	}
+
+	path, _, _ := strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+	return media.NewStore(filepath.Join(filepath.Dir(path), "media")), nil
+}
+
+// mediaReferences returns the hash prefix of every media reference in posts,
+// including posts in the trash, and their revisions
+func mediaReferences(ctx context.Context, db database.Storage) ([]string, error) {
+	contents, err := db.ListPostContents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list post content: %w", err)
+	}
+
+	var prefixes []string
+	for _, content := range contents {
+		prefixes = append(prefixes, media.References(content)...)
+	}
+	return prefixes, nil
+}
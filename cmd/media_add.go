@@ -0,0 +1,110 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// mediaAddCmd represents the media add command
+var mediaAddCmd = &cobra.Command{
+	Use:   "add <file>...",
+	Short: "Used to add files to the media library",
+	Long: `Copy files into the media library and print the reference to use for each
+in post content.
+
+Examples:
+  cms media add ./diagram.png
+  cms media add ./photos/*.jpg`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: addMedia,
+}
+
+func addMedia(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	store, err := mediaStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	var items []output.Media
+	for _, path := range args {
+		file, err := store.Add(path)
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", path, err)
+		}
+
+		record, existed, err := db.AddMedia(ctx, database.Media{
+			Hash:     file.Hash,
+			Filename: file.Filename,
+			MimeType: file.MimeType,
+			Size:     file.Size,
+			Width:    sql.NullInt64{Int64: int64(file.Width), Valid: file.Width > 0},
+			Height:   sql.NullInt64{Int64: int64(file.Height), Valid: file.Height > 0},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s: %w", path, err)
+		}
+
+		items = append(items, output.NewMedia(*record))
+		if !printer.IsTable() {
+			continue
+		}
+
+		if existed {
+			ui.PrintInfo("%s is already in the library as %s\n", path, record.Filename)
+		} else {
+			ui.PrintSuccess("Added %s\n", path)
+		}
+		ui.Field("Reference", ui.LinkString(media.Ref(record.Hash)))
+		ui.Field("Markdown", mediaMarkdown(*record))
+	}
+
+	if !printer.IsTable() {
+		return printer.PrintList(items)
+	}
+
+	return nil
+}
+
+// mediaMarkdown returns the Markdown to show a file in a post: an image for
+// images and a link for anything else
+func mediaMarkdown(m database.Media) string {
+	if strings.HasPrefix(m.MimeType, "image/") {
+		return fmt.Sprintf("![%s](%s)", m.Filename, media.Ref(m.Hash))
+	}
+	return fmt.Sprintf("[%s](%s)", m.Filename, media.Ref(m.Hash))
+}
+
+func init() {
+	mediaCmd.AddCommand(mediaAddCmd)
+}
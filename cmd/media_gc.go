@@ -0,0 +1,133 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// mediaGCCmd represents the media gc command
+var mediaGCCmd = &cobra.Command{
+	Use:   "gc",
+	Short: "Used to remove media that nothing refers to",
+	Long: `Remove files from the media library that no post, post in the trash or
+revision refers to, along with any file in the store the library doesn't know
+about.
+
+Examples:
+  # Preview what would be removed
+  cms media gc --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: collectMedia,
+}
+
+func collectMedia(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	dryRun, err := cmd.Flags().GetBool(dryRunFlagName)
+	if err != nil {
+		return err
+	}
+
+	store, err := mediaStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	references, err := mediaReferences(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	files, err := db.ListMedia(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list media: %w", err)
+	}
+
+	verb := "Removed"
+	if dryRun {
+		verb = "Would remove"
+	}
+
+	recorded := map[string]bool{}
+	var removed int
+	var freed int64
+	for _, file := range files {
+		recorded[file.Hash] = true
+		if media.Referenced(file.Hash, references) {
+			continue
+		}
+
+		if !dryRun {
+			if err := db.DeleteMedia(ctx, file.Hash); err != nil {
+				return fmt.Errorf("failed to remove media: %w", err)
+			}
+			if err := store.Remove(file.Hash); err != nil {
+				return fmt.Errorf("failed to remove %s from the store: %w", file.Filename, err)
+			}
+		}
+
+		ui.PrintInfo("%s %s (%s)\n", verb, media.Ref(file.Hash), file.Filename)
+		removed++
+		freed += file.Size
+	}
+
+	// Files can be left in the store without a record, for instance when
+	// adding one was interrupted
+	hashes, err := store.Hashes()
+	if err != nil {
+		return fmt.Errorf("failed to read the media store: %w", err)
+	}
+
+	var orphans int
+	for _, hash := range hashes {
+		if recorded[hash] {
+			continue
+		}
+
+		if !dryRun {
+			if err := store.Remove(hash); err != nil {
+				return fmt.Errorf("failed to remove %s from the store: %w", hash, err)
+			}
+		}
+		orphans++
+	}
+
+	if removed == 0 && orphans == 0 {
+		ui.PrintInfo("Nothing to remove.\n")
+		return nil
+	}
+
+	ui.PrintSuccess("%s %d unused file(s), freeing %s\n", verb, removed, media.FormatSize(freed))
+	if orphans > 0 {
+		ui.PrintInfo("%s %d file(s) from the store that weren't in the library\n", verb, orphans)
+	}
+
+	return nil
+}
+
+func init() {
+	mediaCmd.AddCommand(mediaGCCmd)
+
+	mediaGCCmd.Flags().Bool(dryRunFlagName, false, "Show what would be removed without removing anything")
+}
@@ -0,0 +1,97 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// mediaListCmd represents the media list command
+var mediaListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Used to list the files in the media library",
+	Args:  cobra.NoArgs,
+	RunE:  listMedia,
+}
+
+func listMedia(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	files, err := db.ListMedia(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list media: %w", err)
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !printer.IsTable() {
+		items := make([]output.Media, len(files))
+		for i, file := range files {
+			items[i] = output.NewMedia(file)
+		}
+		return printer.PrintList(items)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("🖼️  No media found.")
+		return nil
+	}
+
+	ui.Header("Media")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("REFERENCE\tFILENAME\tTYPE\tSIZE\tDIMENSIONS\tADDED"))
+	fmt.Fprintln(w, ui.SubtleString("---------\t--------\t----\t----\t----------\t-----"))
+
+	for _, file := range files {
+		dimensions := ""
+		if file.Width.Valid && file.Height.Valid {
+			dimensions = fmt.Sprintf("%d×%d", file.Width.Int64, file.Height.Int64)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			ui.LinkString(media.Ref(file.Hash)),
+			file.Filename,
+			file.MimeType,
+			media.FormatSize(file.Size),
+			dimensions,
+			ui.SubtleString(file.CreatedAt.Time.Local().Format("2006-01-02 15:04")),
+		)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+	ui.PrintInfo("Found %d file(s)\n", len(files))
+
+	return nil
+}
+
+func init() {
+	mediaCmd.AddCommand(mediaListCmd)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// mediaRmCmd represents the media rm command
+var mediaRmCmd = &cobra.Command{
+	Use:     "rm <reference>...",
+	Aliases: []string{"remove"},
+	Short:   "Used to remove files from the media library",
+	Long: `Remove files from the media library by their reference, as in media:3f2a9c1b7e4d.
+Files that posts or their revisions still refer to are only removed with --force.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: removeMedia,
+}
+
+func removeMedia(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get force flag
+	force, err := cmd.Flags().GetBool(forceFlagName)
+	if err != nil {
+		return err
+	}
+
+	store, err := mediaStore(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	references, err := mediaReferences(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range args {
+		file, err := db.GetMedia(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("failed to get media: %w", err)
+		}
+
+		if !force && media.Referenced(file.Hash, references) {
+			ui.PrintWarning("%s (%s) is used by a post. Use --force to remove it anyway.\n", media.Ref(file.Hash), file.Filename)
+			continue
+		}
+
+		if err := db.DeleteMedia(ctx, file.Hash); err != nil {
+			return fmt.Errorf("failed to remove media: %w", err)
+		}
+		if err := store.Remove(file.Hash); err != nil {
+			return fmt.Errorf("failed to remove %s from the store: %w", file.Filename, err)
+		}
+
+		ui.PrintSuccess("Removed %s (%s)\n", media.Ref(file.Hash), file.Filename)
+	}
+
+	return nil
+}
+
+func init() {
+	mediaCmd.AddCommand(mediaRmCmd)
+
+	mediaRmCmd.Flags().BoolP(forceFlagName, "f", false, "Remove files that posts still refer to")
+}
@@ -0,0 +1,45 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/spf13/cobra"
+)
+
+// newPrinter creates a printer for the format given by the global --output flag
+func newPrinter(cmd *cobra.Command) (*output.Printer, error) {
+	value, err := cmd.Flags().GetString(outputFlagName)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.New(os.Stdout, value)
+}
+
+// postOutput converts a post into its machine-readable form, including its tags
+func postOutput(ctx context.Context, db database.Storage, post *database.Post) (output.Post, error) {
+	tags, err := db.GetPostTags(ctx, int(post.ID))
+	if err != nil {
+		return output.Post{}, fmt.Errorf("failed to get post tags: %w", err)
+	}
+
+	return output.NewPost(post, tags), nil
+}
+
+// printPost writes a single post using the printer's format
+func printPost(ctx context.Context, db database.Storage, printer *output.Printer, post *database.Post) error {
+	record, err := postOutput(ctx, db, post)
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(record)
+}
@@ -5,6 +5,12 @@ Copyright © 2025 dreamsofcode
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -26,4 +32,60 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// postsCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
-}
\ No newline at end of file
+}
+
+// lookupPost finds the post identified by the --id or --slug flag
+func lookupPost(ctx context.Context, cmd *cobra.Command, db database.Storage) (*database.Post, error) {
+	idSet := cmd.Flags().Changed(idFlagName)
+	slugSet := cmd.Flags().Changed(slugFlagName)
+
+	if !idSet && !slugSet {
+		return nil, errors.New("either --id or --slug flag must be set")
+	}
+
+	if idSet && slugSet {
+		return nil, errors.New("cannot use both --id and --slug flags together")
+	}
+
+	if idSet {
+		id, err := cmd.Flags().GetInt(idFlagName)
+		if err != nil {
+			return nil, err
+		}
+		return db.GetPostByID(ctx, id)
+	}
+
+	slug, err := cmd.Flags().GetString(slugFlagName)
+	if err != nil {
+		return nil, err
+	}
+	return getPostBySlug(ctx, cmd, db, slug)
+}
+
+// getPostBySlug finds a post by its slug, following any redirect from an old
+// slug. Following one is noted on stderr, so it doesn't mix with --output
+func getPostBySlug(ctx context.Context, cmd *cobra.Command, db database.Storage, slug string) (*database.Post, error) {
+	post, redirected, err := db.GetPostBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if redirected {
+		fmt.Fprintln(cmd.ErrOrStderr(), ui.InfoString("ℹ️  %s redirects to %s", slug, post.Slug.String))
+	}
+
+	return post, nil
+}
+
+// printPostStatus displays the publication state of a post
+func printPostStatus(post *database.Post) {
+	ui.Field("ID", post.ID)
+	ui.Field("Title", ui.HighlightString(post.Title))
+	ui.Field("Status", post.Status)
+	if post.PublishedAt.Valid {
+		ui.Field("Published", post.PublishedAt.Time.Local().Format("2006-01-02 15:04:05"))
+	}
+	if post.ScheduledFor.Valid {
+		ui.Field("Scheduled", post.ScheduledFor.Time.Local().Format("2006-01-02 15:04:05"))
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	dueFlagName = "due"
+)
+
+// publishCmd represents the publish command
+var publishCmd = &cobra.Command{
+	Use:   "publish",
+	Short: "Used to publish a post",
+	Long: `Publish a post immediately, or publish every scheduled post that is due.
+
+Examples:
+  # Publish a post by slug
+  cms posts publish --slug my-post
+
+  # Publish all scheduled posts whose time has come
+  cms posts publish --due`,
+	RunE: publishPost,
+}
+
+func publishPost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get verbose flag
+	verbose, err := cmd.Flags().GetBool(verboseFlagName)
+	if err != nil {
+		return err
+	}
+
+	due, err := cmd.Flags().GetBool(dueFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if due {
+		if verbose {
+			ui.PrintInfo("Publishing scheduled posts that are due...\n")
+		}
+
+		count, err := db.PublishDuePosts(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to publish scheduled posts: %w", err)
+		}
+
+		ui.PrintSuccess("Published %d scheduled post(s)\n", count)
+		return nil
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if verbose {
+		ui.PrintInfo("Publishing post with ID: %d\n", post.ID)
+	}
+
+	publishedPost, err := db.PublishPost(ctx, int(post.ID))
+	if err != nil {
+		return fmt.Errorf("failed to publish post: %w", err)
+	}
+
+	ui.PrintSuccess("Post published successfully!\n")
+	printPostStatus(publishedPost)
+
+	return nil
+}
+
+func init() {
+	postsCmd.AddCommand(publishCmd)
+
+	publishCmd.Flags().Int(idFlagName, 0, "ID of the post to publish")
+	publishCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to publish")
+	publishCmd.Flags().Bool(dueFlagName, false, "Publish all scheduled posts whose scheduled time has passed")
+}
@@ -0,0 +1,22 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// redirectsCmd represents the redirects command
+var redirectsCmd = &cobra.Command{
+	Use:   "redirects",
+	Short: "Used to manage redirects from old post slugs",
+	Long: `Manage redirects from old post slugs. Changing a post's slug records a
+redirect from the old slug automatically, and "cms posts get --slug" follows
+them. Export them with "cms redirects export" to keep your hosting in sync.`,
+}
+
+func init() {
+	rootCmd.AddCommand(redirectsCmd)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// redirectsAddCmd represents the redirects add command
+var redirectsAddCmd = &cobra.Command{
+	Use:   "add <from-slug> <to-slug>",
+	Short: "Used to redirect a slug to a post",
+	Long: `Redirect a slug to the post at another slug. The redirect follows the post
+if its slug changes later. The slug redirected from can't belong to a post.`,
+	Args: cobra.ExactArgs(2),
+	RunE: addRedirect,
+}
+
+func addRedirect(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	redirect, err := db.AddRedirect(ctx, args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("failed to add redirect: %w", err)
+	}
+
+	ui.PrintSuccess("'%s' now redirects to '%s'\n", redirect.FromSlug, redirect.ToSlug.String)
+
+	return nil
+}
+
+func init() {
+	redirectsCmd.AddCommand(redirectsAddCmd)
+}
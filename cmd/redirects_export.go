@@ -0,0 +1,109 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/redirect"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// redirectsExportCmd represents the redirects export command
+var redirectsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Used to export redirects as web server configuration",
+	Long: `Export redirects between the post pages written by "cms build" as
+configuration for nginx, Netlify or Apache. Redirects to posts in the trash
+are left out.
+
+  nginx    rewrite directives to include in a server block
+  netlify  a _redirects file for the root of the site
+  apache   RedirectMatch directives for .htaccess or a vhost
+
+Examples:
+  cms redirects export --format nginx > /etc/nginx/snippets/cms-redirects.conf
+  cms redirects export --format netlify --out ./public/_redirects
+  cms redirects export --format apache --out ./public/.htaccess`,
+	Args: cobra.NoArgs,
+	RunE: exportRedirects,
+}
+
+func exportRedirects(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	formatValue, err := cmd.Flags().GetString(formatFlagName)
+	if err != nil {
+		return err
+	}
+
+	format, err := redirect.ParseFormat(formatValue)
+	if err != nil {
+		return err
+	}
+
+	out, err := cmd.Flags().GetString(outFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	redirects, err := db.ListRedirects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list redirects: %w", err)
+	}
+
+	rules := redirect.Rules(redirects)
+
+	if out == "" || out == "-" {
+		return redirect.Write(os.Stdout, format, rules)
+	}
+
+	if err := writeRedirectsFile(out, format, rules); err != nil {
+		return fmt.Errorf("failed to write redirects: %w", err)
+	}
+
+	ui.PrintSuccess("Wrote %d %s redirect(s) to %s\n", len(rules), format, ui.LinkString(out))
+
+	return nil
+}
+
+// writeRedirectsFile writes redirects to a file, replacing any existing one
+func writeRedirectsFile(path string, format redirect.Format, rules []redirect.Rule) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := redirect.Write(file, format, rules); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func init() {
+	redirectsCmd.AddCommand(redirectsExportCmd)
+
+	redirectsExportCmd.Flags().String(formatFlagName, "", "Redirect format: nginx, netlify or apache (required)")
+	redirectsExportCmd.Flags().String(outFlagName, "", "File to write the redirects to (default stdout)")
+	redirectsExportCmd.MarkFlagRequired(formatFlagName)
+}
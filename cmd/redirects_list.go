@@ -0,0 +1,89 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// redirectsListCmd represents the redirects list command
+var redirectsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Used to list redirects from old post slugs",
+	Args:  cobra.NoArgs,
+	RunE:  listRedirects,
+}
+
+func listRedirects(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	redirects, err := db.ListRedirects(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list redirects: %w", err)
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !printer.IsTable() {
+		items := make([]output.Redirect, len(redirects))
+		for i, redirect := range redirects {
+			items[i] = output.NewRedirect(redirect)
+		}
+		return printer.PrintList(items)
+	}
+
+	if len(redirects) == 0 {
+		fmt.Println("↪️  No redirects found.")
+		return nil
+	}
+
+	ui.Header("Redirects")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("FROM\tTO\tPOST\tCREATED"))
+	fmt.Fprintln(w, ui.SubtleString("----\t--\t----\t-------"))
+
+	for _, redirect := range redirects {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n",
+			redirect.FromSlug,
+			ui.LinkString(redirect.ToSlug.String),
+			redirect.PostID,
+			ui.SubtleString(redirect.CreatedAt.Time.Local().Format("2006-01-02 15:04")),
+		)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+	ui.PrintInfo("Found %d redirect(s)\n", len(redirects))
+
+	return nil
+}
+
+func init() {
+	redirectsCmd.AddCommand(redirectsListCmd)
+}
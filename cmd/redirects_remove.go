@@ -0,0 +1,51 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// redirectsRemoveCmd represents the redirects remove command
+var redirectsRemoveCmd = &cobra.Command{
+	Use:     "remove <from-slug>",
+	Aliases: []string{"rm"},
+	Short:   "Used to remove a redirect",
+	Args:    cobra.ExactArgs(1),
+	RunE:    removeRedirect,
+}
+
+func removeRedirect(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if err := db.RemoveRedirect(ctx, args[0]); err != nil {
+		return fmt.Errorf("failed to remove redirect: %w", err)
+	}
+
+	ui.PrintSuccess("Redirect from '%s' removed\n", args[0])
+
+	return nil
+}
+
+func init() {
+	redirectsCmd.AddCommand(redirectsRemoveCmd)
+}
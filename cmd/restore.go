@@ -0,0 +1,93 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// restoreCmd represents the restore command
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Used to restore a post to an earlier revision",
+	Long: `Restore the title, author, content and slug of a post from an earlier
+revision. The current version is saved as a new revision first, so a restore
+can be undone, and a slug that changes redirects to the restored one.
+
+Examples:
+  cms posts restore --slug my-post --rev 2`,
+	RunE: restorePost,
+}
+
+func restorePost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if !cmd.Flags().Changed(revFlagName) {
+		return errors.New("--rev flag not set, must be set")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get verbose flag
+	verbose, err := cmd.Flags().GetBool(verboseFlagName)
+	if err != nil {
+		return err
+	}
+
+	rev, err := cmd.Flags().GetInt(revFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if verbose {
+		ui.PrintInfo("Restoring post with ID %d to revision %d\n", post.ID, rev)
+	}
+
+	restoredPost, err := db.RestorePostRevision(ctx, int(post.ID), rev)
+	if err != nil {
+		return fmt.Errorf("failed to restore post: %w", err)
+	}
+
+	ui.PrintSuccess("Post restored to revision %d!\n", rev)
+	ui.Field("ID", restoredPost.ID)
+	ui.Field("Title", ui.HighlightString(restoredPost.Title))
+	if restoredPost.Author.Valid {
+		ui.Field("Author", restoredPost.Author.String)
+	}
+	if restoredPost.UpdatedAt.Valid {
+		ui.Field("Updated", restoredPost.UpdatedAt.Time.Format("2006-01-02 15:04:05"))
+	}
+
+	return nil
+}
+
+func init() {
+	postsCmd.AddCommand(restoreCmd)
+
+	restoreCmd.Flags().Int(idFlagName, 0, "ID of the post to restore")
+	restoreCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to restore")
+	restoreCmd.Flags().Int(revFlagName, 0, "Revision number to restore")
+}
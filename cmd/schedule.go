@@ -0,0 +1,118 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	atFlagName = "at"
+)
+
+// scheduleTimeLayouts are the accepted formats for the --at flag
+var scheduleTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// scheduleCmd represents the schedule command
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Used to schedule a post for future publication",
+	Long: `Schedule a post to be published at a future time.
+
+Scheduled posts are published by running "cms posts publish --due",
+for example from cron.
+
+Examples:
+  cms posts schedule --slug my-post --at "2025-07-01 09:00"
+  cms posts schedule --id 3 --at 2025-07-01T09:00:00Z`,
+	RunE: schedulePost,
+}
+
+func schedulePost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if !cmd.Flags().Changed(atFlagName) {
+		return errors.New("--at flag not set, must be set")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get verbose flag
+	verbose, err := cmd.Flags().GetBool(verboseFlagName)
+	if err != nil {
+		return err
+	}
+
+	atValue, err := cmd.Flags().GetString(atFlagName)
+	if err != nil {
+		return err
+	}
+
+	at, err := parseScheduleTime(atValue)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if verbose {
+		ui.PrintInfo("Scheduling post with ID %d for %s\n", post.ID, at.Format(time.RFC3339))
+	}
+
+	scheduledPost, err := db.SchedulePost(ctx, int(post.ID), at)
+	if err != nil {
+		return fmt.Errorf("failed to schedule post: %w", err)
+	}
+
+	ui.PrintSuccess("Post scheduled successfully!\n")
+	printPostStatus(scheduledPost)
+
+	return nil
+}
+
+// parseScheduleTime parses a time in one of the accepted layouts, using local time
+// when no zone is given
+func parseScheduleTime(value string) (time.Time, error) {
+	for _, layout := range scheduleTimeLayouts {
+		t, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (use RFC3339 or \"YYYY-MM-DD HH:MM\")", value)
+}
+
+func init() {
+	postsCmd.AddCommand(scheduleCmd)
+
+	scheduleCmd.Flags().Int(idFlagName, 0, "ID of the post to schedule")
+	scheduleCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to schedule")
+	scheduleCmd.Flags().String(atFlagName, "", "Time to publish the post (RFC3339 or \"YYYY-MM-DD HH:MM\")")
+}
@@ -0,0 +1,149 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// searchCmd represents the search command
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Used to search posts by title, content and author",
+	Long: `Search posts using full-text search. Results are ordered by relevance.
+Searching a SQLite database needs cms built with the sqlite_fts5 tag.
+
+Query syntax:
+  golang               posts containing the word
+  "static site"        posts containing the exact phrase
+  gorout*              words starting with a prefix
+  title:golang         only match in a field (title, content, author)
+  golang OR rust       either word, NOT excludes a word
+
+Examples:
+  cms posts search "getting started"
+  cms posts search 'title:welcome'`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: searchPosts,
+}
+
+func searchPosts(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Get local flags
+	limit, err := cmd.Flags().GetInt(limitFlagName)
+	if err != nil {
+		return err
+	}
+
+	offset, err := cmd.Flags().GetInt(offsetFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	query := strings.Join(args, " ")
+
+	results, err := db.SearchPosts(ctx, query, limit, offset)
+	if err != nil {
+		return fmt.Errorf("failed to search posts: %w", err)
+	}
+
+	if !printer.IsTable() {
+		records := make([]output.SearchResult, len(results))
+		for i, result := range results {
+			post, err := postOutput(ctx, db, result.Post)
+			if err != nil {
+				return err
+			}
+			records[i] = output.SearchResult{
+				Post:    post,
+				Rank:    result.Rank,
+				Snippet: result.Snippet,
+			}
+		}
+		return printer.PrintList(records)
+	}
+
+	if len(results) == 0 {
+		fmt.Println("🔍 No posts matched your search.")
+		return nil
+	}
+
+	ui.Header(fmt.Sprintf("Results for \"%s\"", query))
+
+	for _, result := range results {
+		slug := "(no slug)"
+		if result.Post.Slug.Valid {
+			slug = result.Post.Slug.String
+		}
+
+		fmt.Printf("%s %s %s\n",
+			ui.SubtleString(fmt.Sprintf("#%d", result.Post.ID)),
+			ui.HighlightString(result.Post.Title),
+			ui.LinkString(slug),
+		)
+		fmt.Printf("   %s\n\n", highlightSnippet(result.Snippet))
+	}
+
+	ui.PrintInfo("Found %d result(s)\n", len(results))
+
+	return nil
+}
+
+// highlightSnippet flattens a search snippet onto one line and colors the matched terms
+func highlightSnippet(snippet string) string {
+	snippet = strings.Join(strings.Fields(snippet), " ")
+
+	var b strings.Builder
+	for {
+		start := strings.Index(snippet, database.SnippetHighlightStart)
+		if start < 0 {
+			break
+		}
+		end := strings.Index(snippet[start:], database.SnippetHighlightEnd)
+		if end < 0 {
+			break
+		}
+		end += start
+
+		b.WriteString(snippet[:start])
+		b.WriteString(ui.InfoString(snippet[start+len(database.SnippetHighlightStart) : end]))
+		snippet = snippet[end+len(database.SnippetHighlightEnd):]
+	}
+	b.WriteString(snippet)
+
+	return b.String()
+}
+
+func init() {
+	postsCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().IntP(limitFlagName, "l", 10, "Maximum number of results to return")
+	searchCmd.Flags().IntP(offsetFlagName, "o", 0, "Number of results to skip")
+}
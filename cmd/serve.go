@@ -0,0 +1,107 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/server"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	addrFlagName  = "addr"
+	tokenFlagName = "token"
+)
+
+// defaultServeAddr only accepts connections from this machine
+const defaultServeAddr = "127.0.0.1:8080"
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Used to serve posts over a JSON REST API",
+	Long: `Serve posts over a JSON REST API until interrupted.
+
+Endpoints:
+  GET    /api/posts?limit=10&offset=0[&status=published|&tag=go]
+  POST   /api/posts
+  GET    /api/posts/{id}          GET    /api/posts/slug/{slug}
+  PATCH  /api/posts/{id}          PATCH  /api/posts/slug/{slug}
+  DELETE /api/posts/{id}          DELETE /api/posts/slug/{slug}
+
+The API listens on 127.0.0.1 unless --addr says otherwise. When a token is
+set with --token or CMS_API_TOKEN, creating, updating and deleting posts needs
+an "Authorization: Bearer <token>" header. Requests for a post by an old slug
+are redirected to its current one with a 308, whatever their method.
+
+Examples:
+  cms serve
+  CMS_API_TOKEN=secret cms serve --addr :8080`,
+	RunE: serve,
+}
+
+func serve(cmd *cobra.Command, args []string) error {
+	// Stop gracefully on Ctrl+C or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	addr, err := cmd.Flags().GetString(addrFlagName)
+	if err != nil {
+		return err
+	}
+
+	token, err := cmd.Flags().GetString(tokenFlagName)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		token = os.Getenv("CMS_API_TOKEN")
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	srv := server.New(db, server.WithToken(token))
+
+	err = srv.ListenAndServe(ctx, addr, func(listenAddr net.Addr) {
+		ui.PrintSuccess("Serving the API on %s\n", ui.LinkString("http://%s/api/posts", listenAddr))
+		if tcpAddr, ok := listenAddr.(*net.TCPAddr); ok && !tcpAddr.IP.IsLoopback() && token == "" {
+			ui.PrintWarning("Anyone who can reach %s can change posts, set --token or CMS_API_TOKEN to require a token\n", listenAddr)
+		}
+		ui.PrintInfo("Press Ctrl+C to stop\n")
+	})
+	if err != nil {
+		return fmt.Errorf("server failed: %w", err)
+	}
+
+	ui.PrintInfo("Server stopped\n")
+
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().String(addrFlagName, defaultServeAddr, "Address to listen on")
+	serveCmd.Flags().String(tokenFlagName, "", "Bearer token required to change posts (default $CMS_API_TOKEN)")
+}
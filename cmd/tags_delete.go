@@ -0,0 +1,51 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// tagsDeleteCmd represents the tags delete command
+var tagsDeleteCmd = &cobra.Command{
+	Use:     "delete <name>",
+	Aliases: []string{"remove"},
+	Short:   "Used to delete a tag and remove it from all posts",
+	Args:    cobra.ExactArgs(1),
+	RunE:    deleteTag,
+}
+
+func deleteTag(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if err := db.DeleteTag(ctx, args[0]); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	ui.PrintSuccess("Tag '%s' deleted successfully!\n", args[0])
+
+	return nil
+}
+
+func init() {
+	tagsCmd.AddCommand(tagsDeleteCmd)
+}
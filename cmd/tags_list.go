@@ -0,0 +1,69 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// tagsListCmd represents the tags list command
+var tagsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Used to list all tags",
+	RunE:  listTags,
+}
+
+func listTags(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	tags, err := db.ListTags(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		fmt.Println("🏷️  No tags found.")
+		return nil
+	}
+
+	ui.Header("Tags")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("NAME\tPOSTS"))
+	fmt.Fprintln(w, ui.SubtleString("----\t-----"))
+
+	for _, tag := range tags {
+		fmt.Fprintf(w, "%s\t%d\n", ui.HighlightString(tag.Name), tag.PostCount)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+	ui.PrintInfo("Found %d tag(s)\n", len(tags))
+
+	return nil
+}
+
+func init() {
+	tagsCmd.AddCommand(tagsListCmd)
+}
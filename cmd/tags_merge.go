@@ -0,0 +1,73 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	intoFlagName = "into"
+)
+
+// tagsMergeCmd represents the tags merge command
+var tagsMergeCmd = &cobra.Command{
+	Use:   "merge <tag>... --into <tag>",
+	Short: "Used to merge tags into another tag",
+	Long: `Move every post from the given tags onto the target tag and delete the
+merged tags. The target tag is created if it doesn't exist.
+
+Examples:
+  cms tags merge golang go-lang --into go`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: mergeTags,
+}
+
+func mergeTags(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	if !cmd.Flags().Changed(intoFlagName) {
+		return errors.New("--into flag not set, must be set")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	into, err := cmd.Flags().GetString(intoFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	tag, err := db.MergeTags(ctx, args, into)
+	if err != nil {
+		return fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	ui.PrintSuccess("Merged %s into '%s'\n", strings.Join(args, ", "), tag.Name)
+
+	return nil
+}
+
+func init() {
+	tagsCmd.AddCommand(tagsMergeCmd)
+
+	tagsMergeCmd.Flags().String(intoFlagName, "", "Tag to merge into")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// tagsRenameCmd represents the tags rename command
+var tagsRenameCmd = &cobra.Command{
+	Use:   "rename <old-name> <new-name>",
+	Short: "Used to rename a tag",
+	Args:  cobra.ExactArgs(2),
+	RunE:  renameTag,
+}
+
+func renameTag(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	tag, err := db.RenameTag(ctx, args[0], args[1])
+	if err != nil {
+		return fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	ui.PrintSuccess("Tag '%s' renamed to '%s'\n", args[0], tag.Name)
+
+	return nil
+}
+
+func init() {
+	tagsCmd.AddCommand(tagsRenameCmd)
+}
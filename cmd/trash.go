@@ -0,0 +1,19 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// trashCmd represents the trash command
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "Used to manage deleted posts",
+}
+
+func init() {
+	rootCmd.AddCommand(trashCmd)
+}
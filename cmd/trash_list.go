@@ -0,0 +1,79 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// trashListCmd represents the trash list command
+var trashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Used to list posts in the trash",
+	RunE:  listTrash,
+}
+
+func listTrash(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	posts, err := db.ListTrashedPosts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	if len(posts) == 0 {
+		fmt.Println("🗑️  The trash is empty.")
+		return nil
+	}
+
+	ui.Header("Trash")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, ui.HighlightString("ID\tTITLE\tSLUG\tDELETED"))
+	fmt.Fprintln(w, ui.SubtleString("--\t-----\t----\t-------"))
+
+	for _, post := range posts {
+		slug := "(no slug)"
+		if post.Slug.Valid {
+			slug = post.Slug.String
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			post.ID,
+			ui.HighlightString(post.Title),
+			ui.LinkString(slug),
+			ui.SubtleString(post.DeletedAt.Time.Local().Format("2006-01-02 15:04")),
+		)
+	}
+
+	w.Flush()
+	fmt.Printf("\n")
+	ui.PrintInfo("Found %d trashed post(s)\n", len(posts))
+
+	return nil
+}
+
+func init() {
+	trashCmd.AddCommand(trashListCmd)
+}
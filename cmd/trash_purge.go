@@ -0,0 +1,177 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+const (
+	olderThanFlagName = "older-than"
+)
+
+// trashPurgeCmd represents the trash purge command
+var trashPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Used to permanently delete posts in the trash",
+	Long: `Permanently delete posts in the trash. This cannot be undone.
+
+Examples:
+  # Empty the whole trash
+  cms trash purge --force
+
+  # Remove posts that have been in the trash for more than 30 days
+  cms trash purge --older-than 30d --force
+
+  # Remove a single trashed post
+  cms trash purge --slug my-post --force`,
+	RunE: purgeTrash,
+}
+
+func purgeTrash(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	idSet := cmd.Flags().Changed(idFlagName)
+	slugSet := cmd.Flags().Changed(slugFlagName)
+	olderThanSet := cmd.Flags().Changed(olderThanFlagName)
+
+	if idSet && slugSet {
+		return errors.New("cannot use both --id and --slug flags together")
+	}
+
+	if (idSet || slugSet) && olderThanSet {
+		return errors.New("--older-than cannot be combined with --id or --slug")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get force flag
+	force, err := cmd.Flags().GetBool(forceFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	if idSet {
+		id, err := cmd.Flags().GetInt(idFlagName)
+		if err != nil {
+			return err
+		}
+
+		if !force {
+			ui.PrintWarning("Are you sure you want to permanently delete post ID %d? Use --force to skip this confirmation.\n", id)
+			return nil
+		}
+
+		if err := db.PurgePostByID(ctx, id); err != nil {
+			return fmt.Errorf("failed to purge post: %w", err)
+		}
+
+		ui.PrintSuccess("Post with ID %d permanently deleted!\n", id)
+		return nil
+	}
+
+	if slugSet {
+		slug, err := cmd.Flags().GetString(slugFlagName)
+		if err != nil {
+			return err
+		}
+
+		if !force {
+			ui.PrintWarning("Are you sure you want to permanently delete post with slug '%s'? Use --force to skip this confirmation.\n", slug)
+			return nil
+		}
+
+		if err := db.PurgePostBySlug(ctx, slug); err != nil {
+			return fmt.Errorf("failed to purge post: %w", err)
+		}
+
+		ui.PrintSuccess("Post with slug '%s' permanently deleted!\n", slug)
+		return nil
+	}
+
+	before := time.Now()
+	if olderThanSet {
+		olderThan, err := cmd.Flags().GetString(olderThanFlagName)
+		if err != nil {
+			return err
+		}
+
+		age, err := parseAge(olderThan)
+		if err != nil {
+			return err
+		}
+		before = before.Add(-age)
+	}
+
+	if !force {
+		if olderThanSet {
+			ui.PrintWarning("Are you sure you want to permanently delete posts trashed before %s? Use --force to skip this confirmation.\n", before.Format("2006-01-02 15:04"))
+		} else {
+			ui.PrintWarning("Are you sure you want to empty the trash? Use --force to skip this confirmation.\n")
+		}
+		return nil
+	}
+
+	count, err := db.PurgeTrash(ctx, before)
+	if err != nil {
+		return fmt.Errorf("failed to purge trash: %w", err)
+	}
+
+	ui.PrintSuccess("Permanently deleted %d post(s)\n", count)
+
+	return nil
+}
+
+// parseAge parses a duration that may also use day ("30d") or week ("2w") units
+func parseAge(value string) (time.Duration, error) {
+	units := map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+
+	for suffix, unit := range units {
+		if number, ok := strings.CutSuffix(value, suffix); ok {
+			n, err := strconv.Atoi(number)
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid age %q", value)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q (use e.g. 30d, 2w or 12h)", value)
+	}
+	return age, nil
+}
+
+func init() {
+	trashCmd.AddCommand(trashPurgeCmd)
+
+	trashPurgeCmd.Flags().Int(idFlagName, 0, "ID of the trashed post to purge")
+	trashPurgeCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the trashed post to purge")
+	trashPurgeCmd.Flags().String(olderThanFlagName, "", "Only purge posts trashed longer ago than this (e.g. 30d, 2w, 12h)")
+	trashPurgeCmd.Flags().BoolP(forceFlagName, "f", false, "Purge without confirmation")
+}
@@ -0,0 +1,92 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// trashRestoreCmd represents the trash restore command
+var trashRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Used to restore a post from the trash",
+	RunE:  restoreTrashedPost,
+}
+
+func restoreTrashedPost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Check if either id or slug flag was set
+	idSet := cmd.Flags().Changed(idFlagName)
+	slugSet := cmd.Flags().Changed(slugFlagName)
+
+	if !idSet && !slugSet {
+		return errors.New("either --id or --slug flag must be set")
+	}
+
+	if idSet && slugSet {
+		return errors.New("cannot use both --id and --slug flags together")
+	}
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	var post *database.Post
+
+	if idSet {
+		id, err := cmd.Flags().GetInt(idFlagName)
+		if err != nil {
+			return err
+		}
+
+		post, err = db.RestorePostByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to restore post: %w", err)
+		}
+	}
+
+	if slugSet {
+		slug, err := cmd.Flags().GetString(slugFlagName)
+		if err != nil {
+			return err
+		}
+
+		post, err = db.RestorePostBySlug(ctx, slug)
+		if err != nil {
+			return fmt.Errorf("failed to restore post: %w", err)
+		}
+	}
+
+	ui.PrintSuccess("Post restored from the trash!\n")
+	ui.Field("ID", post.ID)
+	ui.Field("Title", ui.HighlightString(post.Title))
+	if post.Slug.Valid {
+		ui.Field("Slug", ui.LinkString(post.Slug.String))
+	}
+
+	return nil
+}
+
+func init() {
+	trashCmd.AddCommand(trashRestoreCmd)
+
+	trashRestoreCmd.Flags().Int(idFlagName, 0, "ID of the post to restore")
+	trashRestoreCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to restore")
+}
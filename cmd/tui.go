@@ -0,0 +1,56 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/tui"
+	"github.com/spf13/cobra"
+)
+
+// tuiCmd represents the tui command
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Used to browse and manage posts in a full-screen terminal UI",
+	Long: `Browse posts in a full-screen terminal UI, with a filterable list and a
+preview of the selected post.
+
+Keys:
+  /          filter the posts
+  enter, e   edit the selected post in your editor
+  n          create a post
+  d          move the selected post to the trash
+  r          reload the posts
+  ctrl+d/u   scroll the preview
+  ?          show all keys
+  q          quit`,
+	Args: cobra.NoArgs,
+	RunE: runTUI,
+}
+
+func runTUI(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	return tui.RunBrowser(ctx, db)
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2025 dreamsofcode
+
+*/
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// unpublishCmd represents the unpublish command
+var unpublishCmd = &cobra.Command{
+	Use:   "unpublish",
+	Short: "Used to move a post back to draft",
+	RunE:  unpublishPost,
+}
+
+func unpublishPost(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+
+	// Get database URL from global flag
+	databaseURL, err := cmd.Flags().GetString(databaseURLFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get verbose flag
+	verbose, err := cmd.Flags().GetBool(verboseFlagName)
+	if err != nil {
+		return err
+	}
+
+	// Get database connection
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	post, err := lookupPost(ctx, cmd, db)
+	if err != nil {
+		return fmt.Errorf("failed to get post: %w", err)
+	}
+
+	if verbose {
+		ui.PrintInfo("Unpublishing post with ID: %d\n", post.ID)
+	}
+
+	draftPost, err := db.UnpublishPost(ctx, int(post.ID))
+	if err != nil {
+		return fmt.Errorf("failed to unpublish post: %w", err)
+	}
+
+	ui.PrintSuccess("Post moved back to draft!\n")
+	printPostStatus(draftPost)
+
+	return nil
+}
+
+func init() {
+	postsCmd.AddCommand(unpublishCmd)
+
+	unpublishCmd.Flags().Int(idFlagName, 0, "ID of the post to unpublish")
+	unpublishCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to unpublish")
+}
@@ -8,13 +8,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
 	"github.com/dreamsofcode-io/cli-cms/internal/editor"
+	"github.com/dreamsofcode-io/cli-cms/internal/forms"
+	"github.com/dreamsofcode-io/cli-cms/internal/handler"
 	"github.com/dreamsofcode-io/cli-cms/internal/ui"
 	"github.com/spf13/cobra"
 )
 
+const (
+	newSlugFlagName = "new-slug"
+)
+
 // updateCmd represents the update command
 var updateCmd = &cobra.Command{
 	Use:     "update",
@@ -43,9 +50,17 @@ func updatePost(cmd *cobra.Command, args []string) error {
 	contentSet := cmd.Flags().Changed(contentFlagName)
 	authorSet := cmd.Flags().Changed(authorFlagName)
 	editorSet := cmd.Flags().Changed(editorFlagName)
+	tagSet := cmd.Flags().Changed(tagFlagName)
+	newSlugSet := cmd.Flags().Changed(newSlugFlagName)
 
-	if !titleSet && !contentSet && !authorSet && !editorSet {
-		return errors.New("at least one field must be specified to update (--title, --content, --author, or --editor)")
+	// The interactive form prefills from the post, so no fields are needed
+	interactive, err := cmd.Flags().GetBool(interactiveFlagName)
+	if err != nil {
+		return err
+	}
+
+	if !interactive && !titleSet && !contentSet && !authorSet && !editorSet && !tagSet && !newSlugSet {
+		return errors.New("at least one field must be specified to update (--title, --content, --author, --new-slug, --tag, --editor or --interactive)")
 	}
 
 	// Get database URL from global flag
@@ -61,7 +76,7 @@ func updatePost(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get database connection
-	db, err := database.GetDatabase(ctx, databaseURL)
+	db, err := database.GetDatabase(ctx, databaseURL, databaseOptions(cmd)...)
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -82,7 +97,7 @@ func updatePost(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
-		existingPost, err = db.GetPostBySlug(ctx, slug)
+		existingPost, err = getPostBySlug(ctx, cmd, db, slug)
 		if err != nil {
 			return fmt.Errorf("failed to get existing post: %w", err)
 		}
@@ -107,45 +122,48 @@ func updatePost(cmd *cobra.Command, args []string) error {
 		updates.Author = database.StringToNullString(author)
 	}
 
-	// Handle content updates
-	if editorSet {
-		// Use editor for content input
-		useEditor, err := cmd.Flags().GetBool(editorFlagName)
+	if newSlugSet {
+		newSlug, err := cmd.Flags().GetString(newSlugFlagName)
 		if err != nil {
 			return err
 		}
+		updates.Slug = database.StringToNullString(newSlug)
+	}
 
-		if useEditor {
-			if verbose {
-				ui.PrintInfo("Opening editor for content editing...\n")
-			}
-
-			ed := editor.New()
-			if !ed.IsAvailable() {
-				return fmt.Errorf("editor not available: %s", ed.GetEditorInfo())
-			}
-
-			if verbose {
-				ui.PrintInfo("Using editor: %s\n", ed.GetEditorInfo())
-			}
-
-			// Use existing post data for template
-			templateTitle := existingPost.Title
-			templateAuthor := database.NullStringToString(existingPost.Author)
-			if titleSet {
-				templateTitle = updates.Title
-			}
-			if authorSet {
-				templateAuthor = database.NullStringToString(updates.Author)
-			}
-
-			editedContent, err := ed.EditContentWithTemplate(templateTitle, templateAuthor, database.NullStringToString(existingPost.Content), true)
-			if err != nil {
-				return fmt.Errorf("failed to edit content: %w", err)
-			}
+	// Tags given as a flag replace the post's tags, and prefill the form and
+	// editor
+	var tags []string
+	if tagSet {
+		tags, err = cmd.Flags().GetStringSlice(tagFlagName)
+		if err != nil {
+			return err
+		}
+		tags = append([]string{}, tags...)
+	}
 
-			updates.Content = database.StringToNullString(editedContent)
+	// Handle content updates. The form and editor can also change the tags and
+	// status
+	var formData *forms.PostFormData
+	if interactive {
+		formData, err = updatePostInteractive(ctx, cmd, db, existingPost, updates)
+		if errors.Is(err, forms.ErrUserCancelled) {
+			ui.PrintWarning("Post update cancelled.\n")
+			return nil
+		}
+		if errors.Is(err, forms.ErrNoChanges) {
+			ui.PrintInfo("No changes to save.\n")
+			return nil
+		}
+		if err != nil {
+			return err
 		}
+
+		updates.Title = formData.Title
+		updates.Author = database.StringToNullString(formData.Author)
+		updates.Slug = database.StringToNullString(formData.Slug)
+		updates.Content = database.StringToNullString(formData.Content)
+		updates.Status = formData.Status
+		tags = append([]string{}, formData.Tags...)
 	} else if contentSet {
 		// Get content from flag
 		content, err := cmd.Flags().GetString(contentFlagName)
@@ -155,40 +173,67 @@ func updatePost(cmd *cobra.Command, args []string) error {
 		updates.Content = database.StringToNullString(content)
 	}
 
-	var updatedPost *database.Post
-
-	if idSet {
-		id, err := cmd.Flags().GetInt(idFlagName)
+	useEditor := false
+	if editorSet && !interactive {
+		useEditor, err = cmd.Flags().GetBool(editorFlagName)
 		if err != nil {
 			return err
 		}
+	}
 
-		if verbose {
-			ui.PrintInfo("Updating post with ID: %d\n", id)
+	// A new author must be a known one, or be added first. One typed into the
+	// editor is checked as the post is saved
+	if !strings.EqualFold(strings.TrimSpace(updates.Author.String), strings.TrimSpace(existingPost.Author.String)) {
+		author, err := resolveAuthor(ctx, cmd, db, updates.Author.String)
+		if errors.Is(err, forms.ErrUserCancelled) {
+			ui.PrintWarning("Post update cancelled.\n")
+			return nil
 		}
-
-		updatedPost, err = db.UpdatePostByID(ctx, id, updates)
 		if err != nil {
-			return fmt.Errorf("failed to update post: %w", err)
+			return err
 		}
+		updates.Author = database.StringToNullString(author)
 	}
 
-	if slugSet {
-		slug, err := cmd.Flags().GetString(slugFlagName)
+	if verbose {
+		ui.PrintInfo("Updating post with ID: %d\n", existingPost.ID)
+	}
+
+	// The post may have been found through a redirect from an old slug, so it
+	// is updated by its ID. Its fields, tags and status are saved together
+	var updatedPost *database.Post
+	var postTags []database.Tag
+	if useEditor {
+		if verbose {
+			ui.PrintInfo("Opening editor for content editing...\n")
+		}
+
+		// The editor template is prefilled from the post and any flags
+		updatedPost, err = handler.NewPosts(db).EditPostWithTags(ctx, &updates, tags)
 		if err != nil {
 			return err
 		}
 
-		if verbose {
-			ui.PrintInfo("Updating post with slug: %s\n", slug)
+		postTags, err = db.GetPostTags(ctx, int(updatedPost.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get tags: %w", err)
 		}
-
-		updatedPost, err = db.UpdatePostBySlug(ctx, slug, updates)
+	} else {
+		updatedPost, postTags, err = db.UpdatePostWithTags(ctx, int(existingPost.ID), updates, tags)
 		if err != nil {
 			return fmt.Errorf("failed to update post: %w", err)
 		}
 	}
 
+	printer, err := newPrinter(cmd)
+	if err != nil {
+		return err
+	}
+
+	if !printer.IsTable() {
+		return printPost(ctx, db, printer, updatedPost)
+	}
+
 	// Display the updated post
 	ui.PrintSuccess("Post updated successfully!\n")
 	ui.Field("ID", updatedPost.ID)
@@ -202,6 +247,15 @@ func updatePost(cmd *cobra.Command, args []string) error {
 	if updatedPost.Slug.Valid {
 		ui.Field("Slug", ui.LinkString(updatedPost.Slug.String))
 	}
+	if updatedPost.Slug != existingPost.Slug && existingPost.Slug.Valid {
+		ui.Field("Redirect", fmt.Sprintf("%s → %s", existingPost.Slug.String, updatedPost.Slug.String))
+	}
+	if useEditor || formData != nil {
+		ui.Field("Status", updatedPost.Status)
+	}
+	if tagSet || useEditor || formData != nil {
+		ui.Field("Tags", formatTags(postTags))
+	}
 	if updatedPost.UpdatedAt.Valid {
 		ui.Field("Updated", updatedPost.UpdatedAt.Time.Format("2006-01-02 15:04:05"))
 	}
@@ -209,16 +263,66 @@ func updatePost(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// updatePostInteractive shows the update form for a post, prefilled with its
+// current data and any changes given as flags
+func updatePostInteractive(ctx context.Context, cmd *cobra.Command, db database.Storage, existing *database.Post, updates database.Post) (*forms.PostFormData, error) {
+	existingTags, err := db.GetPostTags(ctx, int(existing.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing tags: %w", err)
+	}
+
+	current := forms.PostFormData{
+		Title:   existing.Title,
+		Author:  database.NullStringToString(existing.Author),
+		Slug:    database.NullStringToString(existing.Slug),
+		Content: database.NullStringToString(existing.Content),
+		Status:  existing.Status,
+	}
+	for _, tag := range existingTags {
+		current.Tags = append(current.Tags, tag.Name)
+	}
+
+	// Prefill the form with any changes given as flags
+	initialData := current
+	initialData.Title = updates.Title
+	initialData.Author = database.NullStringToString(updates.Author)
+	initialData.Slug = database.NullStringToString(updates.Slug)
+	initialData.Content = database.NullStringToString(updates.Content)
+	if cmd.Flags().Changed(tagFlagName) {
+		initialData.Tags, err = cmd.Flags().GetStringSlice(tagFlagName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Content given as a flag is edited in the form rather than the editor
+	useEditor := !cmd.Flags().Changed(contentFlagName) && editor.New().IsAvailable()
+
+	formData, err := forms.NewUpdateForm(current, initialData, useEditor)
+	if err != nil {
+		if errors.Is(err, forms.ErrUserCancelled) || errors.Is(err, forms.ErrNoChanges) {
+			return nil, err
+		}
+
+		// If TTY error, return helpful error message
+		return nil, fmt.Errorf("interactive mode requires a TTY. Please use regular CLI flags instead: %w", err)
+	}
+
+	return formData, nil
+}
+
 func init() {
 	postsCmd.AddCommand(updateCmd)
 
 	// Add flags for post identification
-	updateCmd.Flags().IntP(idFlagName, "i", 0, "ID of the post to update")
+	updateCmd.Flags().Int(idFlagName, 0, "ID of the post to update")
 	updateCmd.Flags().StringP(slugFlagName, "s", "", "Slug of the post to update")
 	
 	// Add flags for updatable fields
 	updateCmd.Flags().StringP(titleFlagName, "t", "", "New title for the post")
 	updateCmd.Flags().StringP(contentFlagName, "c", "", "New content for the post (ignored if --editor is used)")
 	updateCmd.Flags().StringP(authorFlagName, "a", "", "New author for the post")
+	updateCmd.Flags().String(newSlugFlagName, "", "New slug for the post, the old slug redirects to it")
 	updateCmd.Flags().BoolP(editorFlagName, "e", false, "Open editor for content editing")
+	updateCmd.Flags().StringSlice(tagFlagName, nil, "Replace the post's tags (repeatable or comma-separated, empty to clear)")
 }
\ No newline at end of file
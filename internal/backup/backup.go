@@ -0,0 +1,273 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+)
+
+// ErrChecksumMismatch is returned when a backup doesn't match its manifest
+var ErrChecksumMismatch = errors.New("backup does not match its manifest")
+
+// Manifest describes a backup file, and is written next to it so the backup
+// can be verified before it is restored
+type Manifest struct {
+	File          string    `json:"file"`
+	Size          int64     `json:"size"`
+	SHA256        string    `json:"sha256"`
+	Compressed    bool      `json:"compressed"`
+	SchemaVersion uint      `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ManifestPath is where the manifest for a backup file is written
+func ManifestPath(path string) string {
+	return path + ".manifest.json"
+}
+
+// Create backs up the SQLite database at databaseURL to out, gzip compressing
+// it when compress is set, and writes its manifest. out must not exist
+func Create(ctx context.Context, databaseURL, out string, compress bool) (*Manifest, error) {
+	if _, err := os.Stat(out); err == nil {
+		return nil, fmt.Errorf("%s already exists", out)
+	}
+
+	// VACUUM INTO needs a path that doesn't exist yet
+	tmp, err := tempPath(filepath.Dir(out), filepath.Base(out))
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp)
+
+	if err := database.Backup(ctx, databaseURL, tmp); err != nil {
+		return nil, err
+	}
+
+	version, err := database.CheckBackup(ctx, tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	if compress {
+		err = compressFile(out, tmp)
+	} else {
+		err = os.Rename(tmp, out)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to write backup: %w", err)
+	}
+
+	size, sum, err := checksum(out)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		File:          filepath.Base(out),
+		Size:          size,
+		SHA256:        sum,
+		Compressed:    compress,
+		SchemaVersion: version,
+		CreatedAt:     time.Now().UTC(),
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(ManifestPath(out), append(data, '\n'), 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// RestoreResult describes a restored backup
+type RestoreResult struct {
+	// Manifest is nil when the backup had no manifest to verify it against
+	Manifest      *Manifest
+	SchemaVersion uint
+	// Previous is where the replaced database was moved, empty if there was none
+	Previous string
+}
+
+// Restore verifies the backup at in against its manifest, if it has one,
+// decompresses it if needed and replaces the SQLite database at databaseURL
+// with it. The backup's schema version is checked before anything is replaced
+func Restore(ctx context.Context, databaseURL, in string) (*RestoreResult, error) {
+	manifest, err := readManifest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest != nil {
+		size, sum, err := checksum(in)
+		if err != nil {
+			return nil, err
+		}
+		if size != manifest.Size || sum != manifest.SHA256 {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, ManifestPath(in))
+		}
+	}
+
+	src := in
+	compressed, err := isGzip(in)
+	if err != nil {
+		return nil, err
+	}
+	if compressed {
+		src, err = tempPath("", filepath.Base(in))
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(src)
+
+		if err := decompressFile(src, in); err != nil {
+			return nil, fmt.Errorf("failed to decompress backup: %w", err)
+		}
+	}
+
+	version, err := database.CheckBackup(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := database.Restore(ctx, databaseURL, src)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestoreResult{
+		Manifest:      manifest,
+		SchemaVersion: version,
+		Previous:      previous,
+	}, nil
+}
+
+// readManifest reads the manifest for a backup, returning nil if it has none
+func readManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(ManifestPath(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", ManifestPath(path), err)
+	}
+
+	return &manifest, nil
+}
+
+// tempPath returns an unused file path in dir, which is created by the caller
+func tempPath(dir, pattern string) (string, error) {
+	f, err := os.CreateTemp(dir, pattern+".tmp-*")
+	if err != nil {
+		return "", err
+	}
+	f.Close()
+	return f.Name(), os.Remove(f.Name())
+}
+
+// checksum returns the size and hex encoded SHA-256 of a file
+func checksum(path string) (int64, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// isGzip reports whether a file starts with the gzip magic number
+func isGzip(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	magic, err := bufio.NewReader(f).Peek(2)
+	if errors.Is(err, io.EOF) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return magic[0] == 0x1f && magic[1] == 0x8b, nil
+}
+
+func compressFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+
+	return out.Close()
+}
+
+func decompressFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	zr, err := gzip.NewReader(in)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, zr); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,150 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupDatabase creates a migrated database with the sample posts
+func setupDatabase(t *testing.T) string {
+	dbPath := filepath.Join(t.TempDir(), "cms.db")
+
+	db, err := database.New(context.Background(), dbPath)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	return dbPath
+}
+
+func countPosts(t *testing.T, dbPath string) int {
+	db, err := database.New(context.Background(), dbPath)
+	require.NoError(t, err)
+	defer db.Close()
+
+	posts, err := db.ListPosts(context.Background(), 100, 0)
+	require.NoError(t, err)
+	return len(posts)
+}
+
+func TestCreateAndRestore(t *testing.T) {
+	for _, compress := range []bool{false, true} {
+		name := "plain"
+		if compress {
+			name = "gzip"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			dbPath := setupDatabase(t)
+			out := filepath.Join(t.TempDir(), "backup.db")
+
+			manifest, err := Create(ctx, dbPath, out, compress)
+			require.NoError(t, err)
+			assert.Equal(t, "backup.db", manifest.File)
+			assert.Equal(t, compress, manifest.Compressed)
+			assert.NotZero(t, manifest.SchemaVersion)
+			assert.Len(t, manifest.SHA256, 64)
+			assert.FileExists(t, ManifestPath(out))
+
+			compressed, err := isGzip(out)
+			require.NoError(t, err)
+			assert.Equal(t, compress, compressed)
+
+			// Change the database, then restore the backup over it
+			db, err := database.New(ctx, dbPath)
+			require.NoError(t, err)
+			require.NoError(t, db.DeletePostByID(ctx, 1))
+			require.NoError(t, db.Close())
+			require.Equal(t, 1, countPosts(t, dbPath))
+
+			result, err := Restore(ctx, dbPath, out)
+			require.NoError(t, err)
+			require.NotNil(t, result.Manifest)
+			assert.Equal(t, manifest.SchemaVersion, result.SchemaVersion)
+			assert.Equal(t, dbPath+".before-restore", result.Previous)
+
+			assert.Equal(t, 2, countPosts(t, dbPath))
+			assert.Equal(t, 1, countPosts(t, result.Previous))
+
+			// Restoring again keeps the database replaced the first time
+			again, err := Restore(ctx, dbPath, out)
+			require.NoError(t, err)
+			assert.Equal(t, dbPath+".before-restore-2", again.Previous)
+			assert.Equal(t, 1, countPosts(t, result.Previous))
+			assert.Equal(t, 2, countPosts(t, again.Previous))
+		})
+	}
+}
+
+func TestCreateExistingFile(t *testing.T) {
+	dbPath := setupDatabase(t)
+	out := filepath.Join(t.TempDir(), "backup.db")
+	require.NoError(t, os.WriteFile(out, []byte("keep me"), 0o644))
+
+	_, err := Create(context.Background(), dbPath, out, false)
+	assert.Error(t, err)
+
+	data, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "keep me", string(data))
+}
+
+func TestRestoreChecksumMismatch(t *testing.T) {
+	ctx := context.Background()
+	dbPath := setupDatabase(t)
+	out := filepath.Join(t.TempDir(), "backup.db")
+
+	_, err := Create(ctx, dbPath, out, false)
+	require.NoError(t, err)
+
+	f, err := os.OpenFile(out, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteString("corrupt")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = Restore(ctx, dbPath, out)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.NoFileExists(t, dbPath+".before-restore")
+}
+
+func TestRestoreRejectsNewerSchema(t *testing.T) {
+	ctx := context.Background()
+	dbPath := setupDatabase(t)
+	out := filepath.Join(t.TempDir(), "backup.db")
+
+	_, err := Create(ctx, dbPath, out, false)
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(ManifestPath(out)))
+
+	conn, err := sql.Open("sqlite3", out)
+	require.NoError(t, err)
+	_, err = conn.Exec("UPDATE schema_migrations SET version = 999")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	_, err = Restore(ctx, dbPath, out)
+	assert.ErrorIs(t, err, database.ErrInvalidBackup)
+	assert.NoFileExists(t, dbPath+".before-restore")
+}
+
+func TestRestoreRejectsNonDatabase(t *testing.T) {
+	dbPath := setupDatabase(t)
+	in := filepath.Join(t.TempDir(), "backup.db")
+	require.NoError(t, os.WriteFile(in, []byte("not a database"), 0o644))
+
+	_, err := Restore(context.Background(), dbPath, in)
+	assert.ErrorIs(t, err, database.ErrInvalidBackup)
+}
+
+func TestPostgresUnsupported(t *testing.T) {
+	_, err := Create(context.Background(), "postgres://localhost/cms", filepath.Join(t.TempDir(), "backup.db"), false)
+	assert.ErrorIs(t, err, database.ErrUnsupportedDriver)
+}
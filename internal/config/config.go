@@ -0,0 +1,389 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ProjectFileName is the name of a project's config file. cms looks for
+	// it in the working directory and then each directory above it
+	ProjectFileName = ".cms.yaml"
+
+	// DefaultProfile is the profile used when none has been chosen
+	DefaultProfile = "default"
+
+	// EnvPrefix starts the environment variables that override settings, as
+	// in CMS_DATABASE_URL. CMS_PROFILE chooses the profile
+	EnvPrefix = "CMS_"
+)
+
+// Setting keys
+const (
+	KeyDatabaseURL = "database_url"
+	KeyAuthor      = "author"
+	KeyEditor      = "editor"
+	KeyOutput      = "output"
+)
+
+// Keys lists every setting a profile can have
+var Keys = []string{KeyDatabaseURL, KeyAuthor, KeyEditor, KeyOutput}
+
+// Source is where a setting's value came from
+type Source string
+
+const (
+	SourceDefault     Source = "default"
+	SourceGlobal      Source = "global config"
+	SourceProject     Source = "project config"
+	SourceEnvironment Source = "environment"
+	SourceFlag        Source = "flag"
+)
+
+// Profile is a named set of settings
+type Profile struct {
+	DatabaseURL string `yaml:"database_url,omitempty"`
+	Author      string `yaml:"author,omitempty"`
+	Editor      string `yaml:"editor,omitempty"`
+	Output      string `yaml:"output,omitempty"`
+}
+
+// field returns the setting with a key
+func (p *Profile) field(key string) (*string, error) {
+	switch key {
+	case KeyDatabaseURL:
+		return &p.DatabaseURL, nil
+	case KeyAuthor:
+		return &p.Author, nil
+	case KeyEditor:
+		return &p.Editor, nil
+	case KeyOutput:
+		return &p.Output, nil
+	}
+	return nil, fmt.Errorf("unknown setting %q (must be one of %s)", key, strings.Join(Keys, ", "))
+}
+
+// Get returns the value of the setting with a key
+func (p *Profile) Get(key string) (string, error) {
+	field, err := p.field(key)
+	if err != nil {
+		return "", err
+	}
+	return *field, nil
+}
+
+// Set changes the setting with a key. An empty value unsets it
+func (p *Profile) Set(key, value string) error {
+	field, err := p.field(key)
+	if err != nil {
+		return err
+	}
+	*field = value
+	return nil
+}
+
+// File is a config file
+type File struct {
+	CurrentProfile string             `yaml:"current_profile,omitempty"`
+	Profiles       map[string]Profile `yaml:"profiles,omitempty"`
+
+	path string
+}
+
+// LoadFile reads the config file at path. A file that doesn't exist is empty
+func LoadFile(path string) (*File, error) {
+	file := &File{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+// Path returns where the file is read from and saved to
+func (f *File) Path() string {
+	return f.path
+}
+
+// Profile returns a copy of the profile called name
+func (f *File) Profile(name string) (Profile, bool) {
+	profile, ok := f.Profiles[name]
+	return profile, ok
+}
+
+// SetProfile replaces the profile called name
+func (f *File) SetProfile(name string, profile Profile) {
+	if f.Profiles == nil {
+		f.Profiles = map[string]Profile{}
+	}
+	f.Profiles[name] = profile
+}
+
+// Save writes the file, creating its directory. Only the owner can read it,
+// as database URLs can hold passwords
+func (f *File) Save() error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(f); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(f.path, buf.Bytes(), 0600)
+}
+
+// GlobalPath returns the path of the user's config file,
+// $XDG_CONFIG_HOME/cms/config.yaml or ~/.config/cms/config.yaml
+func GlobalPath() (string, error) {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find the config directory: %w", err)
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "cms", "config.yaml"), nil
+}
+
+// FindProjectFile returns the path of the project config file in dir or the
+// nearest directory above it, or "" if there isn't one
+func FindProjectFile(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+
+	for {
+		path := filepath.Join(dir, ProjectFileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// Config is the global config file along with the project's, which takes
+// precedence
+type Config struct {
+	Global *File
+	// Project is nil outside a project
+	Project *File
+}
+
+// Load reads the global config file and the project config file for the
+// working directory wd
+func Load(wd string) (*Config, error) {
+	globalPath, err := GlobalPath()
+	if err != nil {
+		return nil, err
+	}
+
+	global, err := LoadFile(globalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{Global: global}
+
+	if path := FindProjectFile(wd); path != "" {
+		config.Project, err = LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return config, nil
+}
+
+// files returns the config files from lowest to highest precedence, along with
+// the source each stands for
+func (c *Config) files() ([]*File, []Source) {
+	files := []*File{c.Global}
+	sources := []Source{SourceGlobal}
+	if c.Project != nil {
+		files = append(files, c.Project)
+		sources = append(sources, SourceProject)
+	}
+	return files, sources
+}
+
+// ProfileNames lists the profiles defined in any config file
+func (c *Config) ProfileNames() []string {
+	seen := map[string]bool{}
+	files, _ := c.files()
+	for _, file := range files {
+		for name := range file.Profiles {
+			seen[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasProfile reports whether any config file defines the profile called name
+func (c *Config) HasProfile(name string) bool {
+	files, _ := c.files()
+	for _, file := range files {
+		if _, ok := file.Profiles[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Setting is the value of a setting and where it came from
+type Setting struct {
+	Key    string
+	Value  string
+	Source Source
+}
+
+// Settings are the settings in effect for a profile
+type Settings struct {
+	Profile       string
+	ProfileSource Source
+	values        map[string]Setting
+}
+
+// Get returns the setting with a key, which is empty with SourceDefault when
+// nothing sets it
+func (s *Settings) Get(key string) Setting {
+	if setting, ok := s.values[key]; ok {
+		return setting
+	}
+	return Setting{Key: key, Source: SourceDefault}
+}
+
+// Value returns the value of the setting with a key
+func (s *Settings) Value(key string) string {
+	return s.Get(key).Value
+}
+
+// All returns every setting, in the order of Keys
+func (s *Settings) All() []Setting {
+	settings := make([]Setting, len(Keys))
+	for i, key := range Keys {
+		settings[i] = s.Get(key)
+	}
+	return settings
+}
+
+// Override sets a setting from a higher precedence source, such as a flag
+func (s *Settings) Override(key, value string, source Source) {
+	s.values[key] = Setting{Key: key, Value: value, Source: source}
+}
+
+// Resolve works out the settings in effect. The profile is the one given, or
+// else CMS_PROFILE, or else the current profile of the project and then the
+// global config file. Each setting comes from the first of CMS_<KEY>, the
+// project config file and the global config file that sets it. getenv looks
+// up environment variables
+func (c *Config) Resolve(profile string, getenv func(string) string) (*Settings, error) {
+	files, sources := c.files()
+
+	settings := &Settings{
+		Profile:       profile,
+		ProfileSource: SourceFlag,
+		values:        map[string]Setting{},
+	}
+	if settings.Profile == "" {
+		settings.Profile, settings.ProfileSource = getenv(EnvPrefix+"PROFILE"), SourceEnvironment
+	}
+	for i := len(files) - 1; i >= 0 && settings.Profile == ""; i-- {
+		settings.Profile, settings.ProfileSource = files[i].CurrentProfile, sources[i]
+	}
+	if settings.Profile == "" {
+		settings.Profile, settings.ProfileSource = DefaultProfile, SourceDefault
+	}
+
+	if settings.Profile != DefaultProfile && !c.HasProfile(settings.Profile) {
+		return nil, fmt.Errorf("profile %q not found", settings.Profile)
+	}
+
+	for i, file := range files {
+		profile, ok := file.Profile(settings.Profile)
+		if !ok {
+			continue
+		}
+
+		for _, key := range Keys {
+			value, _ := profile.Get(key)
+			if value == "" {
+				continue
+			}
+			if key == KeyDatabaseURL {
+				value = resolveDatabasePath(value, filepath.Dir(file.Path()))
+			}
+			settings.values[key] = Setting{Key: key, Value: value, Source: sources[i]}
+		}
+	}
+
+	for _, key := range Keys {
+		if value := getenv(EnvPrefix + strings.ToUpper(key)); value != "" {
+			settings.values[key] = Setting{Key: key, Value: value, Source: SourceEnvironment}
+		}
+	}
+
+	return settings, nil
+}
+
+// resolveDatabasePath makes a relative SQLite database path in a config file
+// relative to the file's directory rather than wherever cms is run from, and
+// expands a leading ~
+func resolveDatabasePath(databaseURL, dir string) string {
+	driver, path, err := database.ParseURL(databaseURL)
+	if err != nil || driver != database.DriverSQLite || strings.HasPrefix(path, "file:") || strings.HasPrefix(path, ":memory:") {
+		return databaseURL
+	}
+
+	switch {
+	case path == "~" || strings.HasPrefix(path, "~/"):
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return databaseURL
+		}
+		path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+	case !filepath.IsAbs(path):
+		path = filepath.Join(dir, path)
+	default:
+		return databaseURL
+	}
+
+	if scheme, _, found := strings.Cut(databaseURL, "://"); found {
+		return scheme + "://" + path
+	}
+	return path
+}
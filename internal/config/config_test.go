@@ -0,0 +1,144 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func env(vars map[string]string) func(string) string {
+	return func(key string) string {
+		return vars[key]
+	}
+}
+
+func TestFileSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cms", "config.yaml")
+
+	file, err := LoadFile(path)
+	require.NoError(t, err, "a missing file is empty")
+	assert.Empty(t, file.Profiles)
+
+	profile, _ := file.Profile("work")
+	require.NoError(t, profile.Set(KeyDatabaseURL, "postgres://localhost/cms"))
+	require.NoError(t, profile.Set(KeyOutput, "json"))
+	file.SetProfile("work", profile)
+	file.CurrentProfile = "work"
+	require.NoError(t, file.Save())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := LoadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "work", loaded.CurrentProfile)
+	assert.Equal(t, Profile{DatabaseURL: "postgres://localhost/cms", Output: "json"}, loaded.Profiles["work"])
+
+	assert.Error(t, profile.Set("colour", "blue"), "unknown keys are rejected")
+}
+
+func TestFindProjectFile(t *testing.T) {
+	root := t.TempDir()
+	nested := filepath.Join(root, "content", "drafts")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+
+	assert.Empty(t, FindProjectFile(nested))
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, ProjectFileName), []byte("{}"), 0644))
+	assert.Equal(t, filepath.Join(root, ProjectFileName), FindProjectFile(nested))
+}
+
+func TestResolve(t *testing.T) {
+	projectDir := t.TempDir()
+
+	config := &Config{
+		Global: &File{
+			path:           "/home/jane/.config/cms/config.yaml",
+			CurrentProfile: "work",
+			Profiles: map[string]Profile{
+				"default": {DatabaseURL: "sqlite:///home/jane/blog.db", Author: "Jane"},
+				"work":    {DatabaseURL: "postgres://db.internal/cms", Editor: "vim"},
+			},
+		},
+		Project: &File{
+			path: filepath.Join(projectDir, ProjectFileName),
+			Profiles: map[string]Profile{
+				"work": {DatabaseURL: "sqlite://data/cms.db", Output: "yaml"},
+			},
+		},
+	}
+
+	t.Run("current profile and precedence", func(t *testing.T) {
+		settings, err := config.Resolve("", env(nil))
+		require.NoError(t, err)
+
+		assert.Equal(t, "work", settings.Profile)
+		assert.Equal(t, SourceGlobal, settings.ProfileSource)
+
+		assert.Equal(t, Setting{Key: KeyDatabaseURL, Value: "sqlite://" + filepath.Join(projectDir, "data", "cms.db"), Source: SourceProject}, settings.Get(KeyDatabaseURL),
+			"relative SQLite paths are relative to the config file")
+		assert.Equal(t, Setting{Key: KeyEditor, Value: "vim", Source: SourceGlobal}, settings.Get(KeyEditor))
+		assert.Equal(t, "yaml", settings.Value(KeyOutput))
+		assert.Equal(t, Setting{Key: KeyAuthor, Source: SourceDefault}, settings.Get(KeyAuthor), "settings don't leak between profiles")
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		settings, err := config.Resolve("", env(map[string]string{
+			"CMS_PROFILE":      "default",
+			"CMS_DATABASE_URL": "./other.db",
+		}))
+		require.NoError(t, err)
+
+		assert.Equal(t, "default", settings.Profile)
+		assert.Equal(t, SourceEnvironment, settings.ProfileSource)
+		assert.Equal(t, Setting{Key: KeyDatabaseURL, Value: "./other.db", Source: SourceEnvironment}, settings.Get(KeyDatabaseURL))
+		assert.Equal(t, "Jane", settings.Value(KeyAuthor))
+	})
+
+	t.Run("profile given", func(t *testing.T) {
+		settings, err := config.Resolve("default", env(map[string]string{"CMS_PROFILE": "work"}))
+		require.NoError(t, err)
+		assert.Equal(t, "default", settings.Profile)
+		assert.Equal(t, SourceFlag, settings.ProfileSource)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := config.Resolve("staging", env(nil))
+		assert.ErrorContains(t, err, `profile "staging" not found`)
+	})
+
+	t.Run("no config", func(t *testing.T) {
+		settings, err := (&Config{Global: &File{}}).Resolve("", env(nil))
+		require.NoError(t, err)
+		assert.Equal(t, DefaultProfile, settings.Profile)
+		assert.Empty(t, settings.Value(KeyDatabaseURL))
+	})
+
+	assert.Equal(t, []string{"default", "work"}, config.ProfileNames())
+}
+
+func TestResolveDatabasePath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	require.NoError(t, err)
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "cms.db", want: filepath.Join("/srv/blog", "cms.db")},
+		{url: "sqlite://./data/cms.db", want: "sqlite://" + filepath.Join("/srv/blog", "data", "cms.db")},
+		{url: "sqlite:///var/lib/cms.db", want: "sqlite:///var/lib/cms.db"},
+		{url: "~/blog.db", want: filepath.Join(home, "blog.db")},
+		{url: "postgres://localhost/cms", want: "postgres://localhost/cms"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.want, resolveDatabasePath(tt.url, "/srv/blog"))
+		})
+	}
+}
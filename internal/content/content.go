@@ -0,0 +1,358 @@
+package content
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/frontmatter"
+	"github.com/dreamsofcode-io/cli-cms/internal/slug"
+)
+
+// Extension is the file extension used for post files
+const Extension = ".md"
+
+// Action describes what happened to a post file during an export or import
+type Action string
+
+const (
+	ActionWritten   Action = "written"
+	ActionSkipped   Action = "skipped"
+	ActionCreated   Action = "created"
+	ActionUpdated   Action = "updated"
+	ActionUnchanged Action = "unchanged"
+)
+
+// Result reports the outcome for a single post file
+type Result struct {
+	Path   string
+	Slug   string
+	Action Action
+	Reason string
+}
+
+// Frontmatter holds the post metadata stored at the top of each file
+type Frontmatter struct {
+	Title        string     `yaml:"title"`
+	Author       string     `yaml:"author,omitempty"`
+	Slug         string     `yaml:"slug"`
+	Status       string     `yaml:"status,omitempty"`
+	Tags         []string   `yaml:"tags,omitempty"`
+	CreatedAt    *time.Time `yaml:"created_at,omitempty"`
+	UpdatedAt    *time.Time `yaml:"updated_at,omitempty"`
+	PublishedAt  *time.Time `yaml:"published_at,omitempty"`
+	ScheduledFor *time.Time `yaml:"scheduled_for,omitempty"`
+}
+
+// Export writes every post to dir as <slug>.md. Posts without a slug, or with
+// one that would put the file anywhere but directly in dir, are skipped
+func Export(ctx context.Context, db database.Storage, dir string) ([]Result, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	posts, err := db.ListPosts(ctx, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+
+	var results []Result
+	for _, post := range posts {
+		if !post.Slug.Valid || post.Slug.String == "" {
+			results = append(results, Result{
+				Action: ActionSkipped,
+				Reason: fmt.Sprintf("post %d has no slug", post.ID),
+			})
+			continue
+		}
+
+		// Slugs are validated when posts are saved, but a database from before
+		// that may still hold slugs with slashes in them
+		path := filepath.Join(dir, post.Slug.String+Extension)
+		if filepath.Dir(path) != filepath.Clean(dir) {
+			results = append(results, Result{
+				Slug:   post.Slug.String,
+				Action: ActionSkipped,
+				Reason: fmt.Sprintf("post %d has a slug that can't be used as a file name", post.ID),
+			})
+			continue
+		}
+
+		tags, err := db.GetPostTags(ctx, int(post.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags for %s: %w", post.Slug.String, err)
+		}
+
+		data, err := Marshal(post, tags)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+
+		results = append(results, Result{
+			Path:   path,
+			Slug:   post.Slug.String,
+			Action: ActionWritten,
+		})
+	}
+
+	return results, nil
+}
+
+// Import upserts every .md file under dir into the database, matching posts by
+// slug. With dryRun set nothing is written and the results describe what would
+// have happened
+func Import(ctx context.Context, db database.Storage, dir string, dryRun bool) ([]Result, error) {
+	var paths []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(path), Extension) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	var results []Result
+	for _, path := range paths {
+		result, err := importFile(ctx, db, path, dryRun)
+		if err != nil {
+			return results, fmt.Errorf("%s: %w", path, err)
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// Marshal renders a post and its tags as Markdown with frontmatter. A trailing
+// newline is always added, and Unmarshal removes it again
+func Marshal(post *database.Post, tags []database.Tag) ([]byte, error) {
+	meta := Frontmatter{
+		Title:        post.Title,
+		Author:       database.NullStringToString(post.Author),
+		Slug:         database.NullStringToString(post.Slug),
+		Status:       post.Status,
+		Tags:         tagNames(tags),
+		CreatedAt:    timePointer(post.CreatedAt),
+		UpdatedAt:    timePointer(post.UpdatedAt),
+		PublishedAt:  timePointer(post.PublishedAt),
+		ScheduledFor: timePointer(post.ScheduledFor),
+	}
+
+	return frontmatter.Marshal(meta, database.NullStringToString(post.Content)+"\n")
+}
+
+// Unmarshal parses a post file into its frontmatter and content
+func Unmarshal(data []byte) (Frontmatter, string, error) {
+	var meta Frontmatter
+	body, err := frontmatter.Unmarshal(data, &meta)
+	if err != nil {
+		return Frontmatter{}, "", err
+	}
+
+	return meta, strings.TrimSuffix(body, "\n"), nil
+}
+
+func importFile(ctx context.Context, db database.Storage, path string, dryRun bool) (Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Result{}, err
+	}
+
+	meta, body, err := Unmarshal(data)
+	if err != nil {
+		return Result{}, err
+	}
+
+	if meta.Title == "" {
+		return Result{}, errors.New("frontmatter is missing a title")
+	}
+
+	// Fall back to the file name when the frontmatter has no slug
+	if meta.Slug == "" {
+		meta.Slug = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	if err := slug.Validate(meta.Slug); err != nil {
+		return Result{}, fmt.Errorf("%w: %v", database.ErrInvalidSlug, err)
+	}
+
+	status := database.StatusDraft
+	if meta.Status != "" {
+		status, err = database.ParsePostStatus(meta.Status)
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	// Posts can only be written by known authors, which a dry run checks too
+	if author := strings.TrimSpace(meta.Author); author != "" {
+		if _, err := db.GetAuthorByName(ctx, author); err != nil {
+			if errors.Is(err, database.ErrAuthorNotFound) {
+				return Result{}, fmt.Errorf("%w: %q", database.ErrAuthorNotFound, author)
+			}
+			return Result{}, err
+		}
+	}
+
+	result := Result{Path: path, Slug: meta.Slug}
+
+	// A slug the post was renamed from still finds it
+	existing, _, err := db.GetPostBySlug(ctx, meta.Slug)
+	if errors.Is(err, database.ErrPostNotFound) {
+		// A post in the trash keeps its slug, so creating the post would fail
+		if err := checkTrash(ctx, db, meta.Slug); err != nil {
+			return Result{}, err
+		}
+
+		result.Action = ActionCreated
+		if dryRun {
+			return result, nil
+		}
+		return result, createPost(ctx, db, meta, status, body)
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	tags, err := db.GetPostTags(ctx, int(existing.ID))
+	if err != nil {
+		return Result{}, err
+	}
+
+	// Author names match their author regardless of case
+	contentChanged := existing.Title != meta.Title ||
+		database.NullStringToString(existing.Content) != body ||
+		!strings.EqualFold(database.NullStringToString(existing.Author), strings.TrimSpace(meta.Author))
+	tagsChanged := !slices.Equal(tagNames(tags), normalizeTags(meta.Tags))
+	statusChanged := existing.Status != string(status) ||
+		!sameTime(existing.PublishedAt, meta.PublishedAt) ||
+		!sameTime(existing.ScheduledFor, meta.ScheduledFor)
+
+	if !contentChanged && !tagsChanged && !statusChanged {
+		result.Action = ActionUnchanged
+		return result, nil
+	}
+
+	result.Action = ActionUpdated
+	if dryRun {
+		return result, nil
+	}
+
+	id := int(existing.ID)
+
+	if contentChanged {
+		updates := *existing
+		updates.Title = meta.Title
+		updates.Content = database.StringToNullString(body)
+		updates.Author = database.StringToNullString(meta.Author)
+
+		if _, err := db.UpdatePostByID(ctx, id, updates); err != nil {
+			return Result{}, err
+		}
+	}
+
+	if tagsChanged {
+		if _, err := db.SetPostTags(ctx, id, meta.Tags); err != nil {
+			return Result{}, err
+		}
+	}
+
+	if statusChanged {
+		_, err := db.SetPostStatus(ctx, id, status, nullTime(meta.PublishedAt), nullTime(meta.ScheduledFor))
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func createPost(ctx context.Context, db database.Storage, meta Frontmatter, status database.PostStatus, body string) error {
+	post := database.CreatePostFromInput(meta.Title, body, meta.Author, meta.Slug)
+	post.Status = string(status)
+	post.PublishedAt = nullTime(meta.PublishedAt)
+	post.ScheduledFor = nullTime(meta.ScheduledFor)
+	post.CreatedAt = nullTime(meta.CreatedAt)
+	post.UpdatedAt = nullTime(meta.UpdatedAt)
+
+	_, _, err := db.CreatePostWithTags(ctx, post, meta.Tags)
+	return err
+}
+
+// checkTrash returns ErrSlugTaken if a post in the trash uses a slug
+func checkTrash(ctx context.Context, db database.Storage, s string) error {
+	trashed, err := db.ListTrashedPosts(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, post := range trashed {
+		if post.Slug.Valid && post.Slug.String == s {
+			return fmt.Errorf("%w: post %d in the trash uses %q, restore or purge it first", database.ErrSlugTaken, post.ID, s)
+		}
+	}
+	return nil
+}
+
+func tagNames(tags []database.Tag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
+
+// normalizeTags returns tag names the way they are stored: normalized, sorted
+// and without duplicates
+func normalizeTags(tags []string) []string {
+	var names []string
+	for _, tag := range tags {
+		if name := database.NormalizeTagName(tag); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+func sameTime(stored sql.NullTime, t *time.Time) bool {
+	if !stored.Valid || t == nil {
+		return !stored.Valid && t == nil
+	}
+	return stored.Time.Equal(*t)
+}
+
+func timePointer(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
@@ -0,0 +1,227 @@
+package content
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *database.Database {
+	db, err := database.New(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err, "Failed to create test database")
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func actions(results []Result) map[string]Action {
+	m := make(map[string]Action)
+	for _, result := range results {
+		m[result.Slug] = result.Action
+	}
+	return m
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	post := &database.Post{
+		Title:   "Hello",
+		Content: sql.NullString{String: "## Heading\n\nParagraph", Valid: true},
+		Author:  sql.NullString{String: "Jane", Valid: true},
+		Slug:    sql.NullString{String: "hello", Valid: true},
+		Status:  "draft",
+	}
+
+	data, err := Marshal(post, []database.Tag{{Name: "go"}})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(data), "---\ntitle: Hello\nauthor: Jane\nslug: hello\nstatus: draft\ntags:\n  - go\n---\n\n## Heading"))
+
+	meta, body, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello", meta.Title)
+	assert.Equal(t, []string{"go"}, meta.Tags)
+	assert.Equal(t, "## Heading\n\nParagraph", body)
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	dir := t.TempDir()
+
+	_, err := db.CreateAuthor(ctx, database.Author{Name: "Jane"})
+	require.NoError(t, err)
+
+	post, err := db.CreatePost(ctx, database.CreatePostFromInput("Tagged", "Some content", "Jane", "tagged"))
+	require.NoError(t, err)
+	_, err = db.SetPostTags(ctx, int(post.ID), []string{"Go", "cli"})
+	require.NoError(t, err)
+
+	_, err = db.CreatePost(ctx, database.CreatePostFromInput("No slug", "", "", ""))
+	require.NoError(t, err)
+
+	results, err := Export(ctx, db, dir)
+	require.NoError(t, err)
+	assert.Len(t, results, 4)
+	assert.Equal(t, ActionWritten, actions(results)["tagged"])
+	assert.FileExists(t, filepath.Join(dir, "tagged.md"))
+	assert.FileExists(t, filepath.Join(dir, "welcome-to-cms.md"))
+
+	// Importing an unmodified export changes nothing
+	results, err = Import(ctx, db, dir, false)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.Equal(t, ActionUnchanged, result.Action, result.Path)
+	}
+
+	// Edit one file and add another
+	path := filepath.Join(dir, "tagged.md")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data = []byte(strings.Replace(string(data), "Some content", "Edited content", 1))
+	require.NoError(t, os.WriteFile(path, data, 0644))
+
+	newFile := "---\ntitle: Brand new\nstatus: published\ntags: [news]\n---\n\nFresh post\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "brand-new.md"), []byte(newFile), 0644))
+
+	// A dry run only reports what would happen
+	results, err = Import(ctx, db, dir, true)
+	require.NoError(t, err)
+	assert.Equal(t, ActionUpdated, actions(results)["tagged"])
+	assert.Equal(t, ActionCreated, actions(results)["brand-new"])
+	assert.Equal(t, ActionUnchanged, actions(results)["getting-started"])
+
+	_, _, err = db.GetPostBySlug(ctx, "brand-new")
+	assert.ErrorIs(t, err, database.ErrPostNotFound)
+
+	results, err = Import(ctx, db, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, ActionUpdated, actions(results)["tagged"])
+	assert.Equal(t, ActionCreated, actions(results)["brand-new"])
+
+	updated, _, err := db.GetPostBySlug(ctx, "tagged")
+	require.NoError(t, err)
+	assert.Equal(t, "Edited content", updated.Content.String)
+
+	created, _, err := db.GetPostBySlug(ctx, "brand-new")
+	require.NoError(t, err)
+	assert.Equal(t, "Fresh post", created.Content.String)
+	assert.Equal(t, string(database.StatusPublished), created.Status)
+	tags, err := db.GetPostTags(ctx, int(created.ID))
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "news", tags[0].Name)
+
+	// And a second import is a no-op again
+	results, err = Import(ctx, db, dir, false)
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.Equal(t, ActionUnchanged, result.Action, result.Path)
+	}
+}
+
+func TestImportInvalidFile(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.md"), []byte("no frontmatter"), 0644))
+
+	_, err := Import(ctx, db, dir, false)
+	assert.Error(t, err)
+}
+
+func TestImportRejectsInvalidSlugs(t *testing.T) {
+	ctx := context.Background()
+
+	files := map[string]string{
+		"traversal": "---\ntitle: Escape\nslug: ../../escaped\n---\n\nBody\n",
+		"dot dot":   "---\ntitle: Escape\nslug: ..\n---\n\nBody\n",
+		"reserved":  "---\ntitle: Feed\nslug: feed\n---\n\nBody\n",
+	}
+
+	for name, file := range files {
+		t.Run(name, func(t *testing.T) {
+			db := setupTestDB(t)
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "post.md"), []byte(file), 0644))
+
+			for _, dryRun := range []bool{true, false} {
+				_, err := Import(ctx, db, dir, dryRun)
+				assert.ErrorIs(t, err, database.ErrInvalidSlug)
+			}
+		})
+	}
+}
+
+func TestImportSlugInTrash(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	dir := t.TempDir()
+
+	post, err := db.CreatePost(ctx, database.CreatePostFromInput("Trashed", "", "", "trashed"))
+	require.NoError(t, err)
+	require.NoError(t, db.DeletePostByID(ctx, int(post.ID)))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "trashed.md"), []byte("---\ntitle: Again\n---\n\nBody\n"), 0644))
+
+	// The dry run fails the same way the import would
+	for _, dryRun := range []bool{true, false} {
+		_, err := Import(ctx, db, dir, dryRun)
+		assert.ErrorIs(t, err, database.ErrSlugTaken)
+	}
+}
+
+func TestImportUnknownAuthor(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "by-nobody.md"), []byte("---\ntitle: By nobody\nauthor: Nobody\n---\n\nBody\n"), 0644))
+
+	// The dry run fails the same way the import would, and no author is
+	// created
+	for _, dryRun := range []bool{true, false} {
+		_, err := Import(ctx, db, dir, dryRun)
+		assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+	}
+	_, err := db.GetAuthorByName(ctx, "Nobody")
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+
+	_, err = db.CreateAuthor(ctx, database.Author{Name: "Nobody"})
+	require.NoError(t, err)
+	results, err := Import(ctx, db, dir, false)
+	require.NoError(t, err)
+	assert.Equal(t, ActionCreated, actions(results)["by-nobody"])
+}
+
+func TestExportSkipsUnsafeSlugs(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	db, err := database.New(ctx, dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "export")
+
+	// Slugs like this are rejected when posts are saved, so it's written
+	// straight into the database as an older version of cms might have
+	post, err := db.CreatePost(ctx, database.CreatePostFromInput("Escape", "", "", "escape"))
+	require.NoError(t, err)
+
+	conn, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, "UPDATE posts SET slug = ? WHERE id = ?", "../escaped", post.ID)
+	require.NoError(t, err)
+
+	results, err := Export(ctx, db, dir)
+	require.NoError(t, err)
+	assert.Equal(t, ActionSkipped, actions(results)["../escaped"])
+	assert.NoFileExists(t, filepath.Join(root, "escaped.md"))
+}
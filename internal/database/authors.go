@@ -0,0 +1,185 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+)
+
+// Author is an alias for the generated repository Author type
+type Author = repository.Author
+
+// AuthorWithCount is an author along with the number of posts they wrote,
+// not counting posts in the trash
+type AuthorWithCount = repository.ListAuthorsRow
+
+// ListAuthors retrieves all authors, ordered by name
+func (d *Database) ListAuthors(ctx context.Context) ([]AuthorWithCount, error) {
+	return d.repo.ListAuthors(ctx)
+}
+
+// GetAuthorByName retrieves an author by name, ignoring case
+func (d *Database) GetAuthorByName(ctx context.Context, name string) (*Author, error) {
+	author, err := d.repo.GetAuthorByName(ctx, strings.TrimSpace(name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAuthorNotFound
+		}
+		return nil, err
+	}
+
+	return &author, nil
+}
+
+// CreateAuthor adds an author. Names are unique, ignoring case
+func (d *Database) CreateAuthor(ctx context.Context, author Author) (*Author, error) {
+	author.Name = strings.TrimSpace(author.Name)
+	if author.Name == "" {
+		return nil, errors.New("author name cannot be empty")
+	}
+
+	now := sql.NullTime{Time: time.Now(), Valid: true}
+
+	created, err := d.repo.CreateAuthor(ctx, repository.CreateAuthorParams{
+		Name:      author.Name,
+		Email:     author.Email,
+		Bio:       author.Bio,
+		AvatarUrl: author.AvatarUrl,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: %s", ErrAuthorExists, author.Name)
+		}
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// UpdateAuthor replaces the details of the author called name. Renaming an
+// author renames them on all of their posts too
+func (d *Database) UpdateAuthor(ctx context.Context, name string, updates Author) (*Author, error) {
+	updates.Name = strings.TrimSpace(updates.Name)
+	if updates.Name == "" {
+		return nil, errors.New("author name cannot be empty")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	repo := d.withTx(tx)
+
+	existing, err := repo.GetAuthorByName(ctx, strings.TrimSpace(name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrAuthorNotFound
+		}
+		return nil, err
+	}
+
+	updated, err := repo.UpdateAuthor(ctx, repository.UpdateAuthorParams{
+		ID:        existing.ID,
+		Name:      updates.Name,
+		Email:     updates.Email,
+		Bio:       updates.Bio,
+		AvatarUrl: updates.AvatarUrl,
+		UpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, fmt.Errorf("%w: %s", ErrAuthorExists, updates.Name)
+		}
+		return nil, err
+	}
+
+	if updated.Name != existing.Name {
+		err := repo.RenamePostsAuthor(ctx, repository.RenamePostsAuthorParams{
+			Author:   sql.NullString{String: updated.Name, Valid: true},
+			AuthorID: sql.NullInt64{Int64: updated.ID, Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteAuthor deletes the author called name and returns how many posts they
+// wrote, including posts in the trash. Unless force is set, an author with
+// posts is not deleted and ErrAuthorHasPosts is returned. With force, their
+// posts are left without an author
+func (d *Database) DeleteAuthor(ctx context.Context, name string, force bool) (int64, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	repo := d.withTx(tx)
+
+	author, err := repo.GetAuthorByName(ctx, strings.TrimSpace(name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrAuthorNotFound
+		}
+		return 0, err
+	}
+
+	authorID := sql.NullInt64{Int64: author.ID, Valid: true}
+
+	count, err := repo.CountPostsByAuthor(ctx, authorID)
+	if err != nil {
+		return 0, err
+	}
+
+	if count > 0 {
+		if !force {
+			return count, fmt.Errorf("%w: %s has %d post(s)", ErrAuthorHasPosts, author.Name, count)
+		}
+		if err := repo.ClearPostsAuthor(ctx, authorID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := repo.DeleteAuthor(ctx, author.ID); err != nil {
+		return 0, err
+	}
+
+	return count, tx.Commit()
+}
+
+// linkAuthor finds the author a post's author name refers to and returns the
+// author's ID and name as the post stores them. Every post is saved through
+// here, so a name that isn't a known author is rejected with ErrAuthorNotFound
+// however it was entered. An empty name leaves the post without an author
+func linkAuthor(ctx context.Context, repo *repository.Queries, name sql.NullString) (sql.NullInt64, sql.NullString, error) {
+	trimmed := strings.TrimSpace(name.String)
+	if !name.Valid || trimmed == "" {
+		return sql.NullInt64{}, name, nil
+	}
+
+	author, err := repo.GetAuthorByName(ctx, trimmed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sql.NullInt64{}, sql.NullString{}, fmt.Errorf("%w: %q", ErrAuthorNotFound, trimmed)
+	}
+	if err != nil {
+		return sql.NullInt64{}, sql.NullString{}, err
+	}
+
+	return sql.NullInt64{Int64: author.ID, Valid: true}, sql.NullString{String: author.Name, Valid: true}, nil
+}
@@ -0,0 +1,209 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// sqlitePath returns the file path of a SQLite database URL, or
+// ErrUnsupportedDriver for any other database
+func sqlitePath(databaseURL string) (string, error) {
+	driver, dsn, err := ParseURL(databaseURL)
+	if err != nil {
+		return "", err
+	}
+	if driver != DriverSQLite {
+		return "", fmt.Errorf("%w: backups need a SQLite database, use pg_dump and pg_restore for PostgreSQL", ErrUnsupportedDriver)
+	}
+	return dsn, nil
+}
+
+// Backup writes a consistent copy of the SQLite database at databaseURL to
+// dest using VACUUM INTO, which is safe while other connections are using the
+// database. dest must not already exist
+func Backup(ctx context.Context, databaseURL, dest string) error {
+	path, err := sqlitePath(databaseURL)
+	if err != nil {
+		return err
+	}
+
+	// Don't create an empty database by backing up one that doesn't exist
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+
+	db, err := sql.Open("sqlite3", sqliteDSN(path))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", dest); err != nil {
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+
+	return nil
+}
+
+// CheckBackup opens the SQLite database file at path read-only, checks its
+// integrity and returns its schema version. Backups with a dirty schema, no
+// migrations or migrations newer than the embedded ones are rejected
+func CheckBackup(ctx context.Context, path string) (uint, error) {
+	if _, err := os.Stat(path); err != nil {
+		return 0, err
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var integrity string
+	if err := db.QueryRowContext(ctx, "PRAGMA integrity_check").Scan(&integrity); err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidBackup, err)
+	}
+	if integrity != "ok" {
+		return 0, fmt.Errorf("%w: integrity check failed: %s", ErrInvalidBackup, integrity)
+	}
+
+	var version int64
+	var dirty bool
+	err = db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("%w: no schema version found", ErrInvalidBackup)
+	}
+
+	migrations, err := embeddedMigrations("migrations/" + string(DriverSQLite))
+	if err != nil {
+		return 0, err
+	}
+	latest := migrations[len(migrations)-1].Version
+
+	switch {
+	case dirty:
+		return 0, fmt.Errorf("%w: schema is dirty at version %d", ErrInvalidBackup, version)
+	case version <= 0:
+		return 0, fmt.Errorf("%w: no migrations have been applied", ErrInvalidBackup)
+	case uint(version) > latest:
+		return 0, fmt.Errorf("%w: schema version %d is newer than this version of cms supports (%d)", ErrInvalidBackup, version, latest)
+	}
+
+	return uint(version), nil
+}
+
+// sqliteFileSuffixes are the suffixes of a SQLite database's file and its
+// journal files
+var sqliteFileSuffixes = []string{"", "-journal", "-wal", "-shm"}
+
+// Restore replaces the SQLite database at databaseURL with the backup file at
+// src, once CheckBackup accepts it. The backup is copied next to the database
+// and renamed over it, so the database is never left half written. The old
+// database is kept with a .before-restore suffix, numbered so that an earlier
+// one is never overwritten, and its path is returned
+func Restore(ctx context.Context, databaseURL, src string) (string, error) {
+	path, err := sqlitePath(databaseURL)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := CheckBackup(ctx, src); err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".restore-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to restore database: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := copyFile(tmp, src); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to restore database: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to restore database: %w", err)
+	}
+
+	// The old database is moved aside along with its journal, which may hold
+	// changes that haven't been written to the database file yet. A journal
+	// without a database is stale and must not be applied to the restored one
+	previous := ""
+	if _, err := os.Stat(path); err == nil {
+		previous = asidePath(path)
+	}
+
+	var moved []string
+	undo := func() {
+		for _, suffix := range moved {
+			os.Rename(previous+suffix, path+suffix)
+		}
+	}
+
+	for _, suffix := range sqliteFileSuffixes {
+		if previous == "" {
+			if err := os.Remove(path + suffix); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return "", fmt.Errorf("failed to remove %s: %w", path+suffix, err)
+			}
+			continue
+		}
+
+		err := os.Rename(path+suffix, previous+suffix)
+		if errors.Is(err, os.ErrNotExist) && suffix != "" {
+			continue
+		}
+		if err != nil {
+			undo()
+			return "", fmt.Errorf("failed to move the current database aside: %w", err)
+		}
+		moved = append(moved, suffix)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		undo()
+		return "", fmt.Errorf("failed to restore database: %w", err)
+	}
+
+	return previous, nil
+}
+
+// asidePath returns the path to keep the database at path under while a backup
+// replaces it: path.before-restore, or path.before-restore-2, -3 and so on when
+// earlier restores have already used that
+func asidePath(path string) string {
+	aside := path + ".before-restore"
+	for n := 2; ; n++ {
+		if !anyExists(aside, sqliteFileSuffixes) {
+			return aside
+		}
+		aside = fmt.Sprintf("%s.before-restore-%d", path, n)
+	}
+}
+
+// anyExists reports whether a file exists at path with any of the suffixes
+func anyExists(path string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if _, err := os.Lstat(path + suffix); !errors.Is(err, os.ErrNotExist) {
+			return true
+		}
+	}
+	return false
+}
+
+// copyFile copies the file at src into dst and syncs it to disk
+func copyFile(dst *os.File, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if _, err := dst.ReadFrom(in); err != nil {
+		return err
+	}
+	return dst.Sync()
+}
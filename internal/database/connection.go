@@ -6,15 +6,19 @@ import (
 )
 
 var (
-	instance *Database
+	instance Storage
 	once     sync.Once
 )
 
-// GetDatabase returns a singleton database instance
-func GetDatabase(ctx context.Context, databaseURL string) (*Database, error) {
+// GetDatabase returns a singleton storage instance for the database URL
+func GetDatabase(ctx context.Context, databaseURL string, opts ...Option) (Storage, error) {
 	var err error
 	once.Do(func() {
-		instance, err = New(ctx, databaseURL)
+		var db *Database
+		db, err = New(ctx, databaseURL, opts...)
+		if err == nil {
+			instance = db
+		}
 	})
 	return instance, err
 }
\ No newline at end of file
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+	"github.com/dreamsofcode-io/cli-cms/internal/slug"
 	_ "github.com/mattn/go-sqlite3" // SQLite driver
 )
 
@@ -15,63 +18,233 @@ type Post = repository.Post
 
 // Database wraps the sql.DB connection and provides methods for database operations
 type Database struct {
-	db   *sql.DB
-	repo *repository.Queries
+	db     *sql.DB
+	repo   *repository.Queries
+	driver Driver
+	// search is false when SQLite was built without FTS5
+	search bool
 }
 
-// New creates a new database connection and initializes the schema
-func New(ctx context.Context, databaseURL string) (*Database, error) {
-	if databaseURL == "" {
-		databaseURL = "./cms.db" // Default SQLite database file
+// Option defines a function type for configuring how a Database is opened
+type Option func(*options)
+
+type options struct {
+	autoMigrate bool
+}
+
+// WithAutoMigrate returns an Option controlling whether pending migrations are
+// applied when the database is opened. It is on by default. When off, opening a
+// database whose schema isn't up to date fails with ErrMigrationsPending
+func WithAutoMigrate(enabled bool) Option {
+	return func(o *options) {
+		o.autoMigrate = enabled
+	}
+}
+
+// New creates a new database connection and initializes the schema. The driver
+// is chosen from the URL scheme, see ParseURL
+func New(ctx context.Context, databaseURL string, opts ...Option) (*Database, error) {
+	o := options{autoMigrate: true}
+	for _, opt := range opts {
+		opt(&o)
 	}
 
-	db, err := sql.Open("sqlite3", databaseURL)
+	driver, dsn, err := ParseURL(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var db *sql.DB
+	switch driver {
+	case DriverPostgres:
+		db, err = sql.Open("postgres", dsn)
+	default:
+		db, err = sql.Open("sqlite3", sqliteDSN(dsn))
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// Test the connection
 	if err := db.PingContext(ctx); err != nil {
+		db.Close()
 		return nil, err
 	}
 
-	// Run database migrations
-	if err := performMigrations(databaseURL); err != nil {
+	// Run database migrations, or check they have been run
+	if err := prepareSchema(driver, dsn, o.autoMigrate); err != nil {
+		db.Close()
 		return nil, err
 	}
 
-	// Create repository
-	repo := repository.New(db)
-
 	database := &Database{
-		db:   db,
-		repo: repo,
+		db:     db,
+		driver: driver,
+		search: true,
+	}
+
+	if driver == DriverSQLite {
+		if database.search, err = ensureSearchIndex(ctx, db); err != nil {
+			db.Close()
+			return nil, err
+		}
 	}
 
+	// Create repository
+	database.repo = repository.New(database.conn(db))
+
 	return database, nil
 }
 
+// prepareSchema applies pending migrations, or with autoMigrate off makes sure
+// there aren't any
+func prepareSchema(driver Driver, dsn string, autoMigrate bool) error {
+	migrator, err := newMigrator(driver, dsn)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	if !autoMigrate {
+		return migrator.CheckCurrent()
+	}
+
+	return migrator.Up(0)
+}
+
+// Driver returns the kind of database this is
+func (d *Database) Driver() Driver {
+	return d.driver
+}
+
+// conn adapts a connection or transaction for the generated queries
+func (d *Database) conn(db repository.DBTX) repository.DBTX {
+	if d.driver == DriverPostgres {
+		return postgresConn{conn: db}
+	}
+	return db
+}
+
+// withTx returns the generated queries bound to a transaction
+func (d *Database) withTx(tx *sql.Tx) *repository.Queries {
+	return repository.New(d.conn(tx))
+}
+
+// sqliteDSN adds connection parameters to the database path, enabling foreign key
+// enforcement so that ON DELETE CASCADE constraints are honoured
+func sqliteDSN(databaseURL string) string {
+	separator := "?"
+	if strings.Contains(databaseURL, "?") {
+		separator = "&"
+	}
+	return databaseURL + separator + "_foreign_keys=on"
+}
+
 // Close closes the database connection
 func (d *Database) Close() error {
 	return d.db.Close()
 }
 
 
-// CreatePost inserts a new post into the database
+// CreatePost inserts a new post into the database. CreatedAt and UpdatedAt
+// default to the current time when they aren't set. A post without a slug is
+// given one made from its title, suffixed with -2, -3 and so on if a post or
+// a redirect already uses it. A slug that was given must pass slug.Validate
 func (d *Database) CreatePost(ctx context.Context, post Post) (*Post, error) {
-	now := time.Now()
+	created, _, err := d.CreatePostWithTags(ctx, post, nil)
+	return created, err
+}
+
+// CreatePostWithTags inserts a new post, as CreatePost does, and tags it in
+// the same transaction, so the post isn't left half made if tagging fails
+func (d *Database) CreatePostWithTags(ctx context.Context, post Post, tags []string) (*Post, []Tag, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+
+	repo := d.withTx(tx)
+
+	created, err := createPost(ctx, repo, post)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var postTags []Tag
+	if len(tags) > 0 {
+		postTags, err = setPostTags(ctx, repo, int(created.ID), tags)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return created, postTags, nil
+}
+
+// createPost inserts a new post with repo
+func createPost(ctx context.Context, repo *repository.Queries, post Post) (*Post, error) {
+	now := sql.NullTime{Time: time.Now(), Valid: true}
+
+	createdAt := post.CreatedAt
+	if !createdAt.Valid {
+		createdAt = now
+	}
+
+	updatedAt := post.UpdatedAt
+	if !updatedAt.Valid {
+		updatedAt = createdAt
+	}
+
+	// New posts start out as drafts unless a status was provided
+	status := post.Status
+	if status == "" {
+		status = string(StatusDraft)
+	}
+
+	postSlug := post.Slug
+	if !postSlug.Valid || postSlug.String == "" {
+		generated, err := slug.Unique(ctx, slug.Make(post.Title), slugTaken(repo))
+		if err != nil {
+			return nil, err
+		}
+		postSlug = sql.NullString{String: generated, Valid: true}
+	} else if err := slug.Validate(postSlug.String); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSlug, err)
+	}
+
+	authorID, author, err := linkAuthor(ctx, repo, post.Author)
+	if err != nil {
+		return nil, err
+	}
 	
 	params := repository.CreatePostParams{
-		Title:     post.Title,
-		Content:   post.Content,
-		Author:    post.Author,
-		Slug:      post.Slug,
-		CreatedAt: sql.NullTime{Time: now, Valid: true},
-		UpdatedAt: sql.NullTime{Time: now, Valid: true},
+		Title:        post.Title,
+		Content:      post.Content,
+		Author:       author,
+		AuthorID:     authorID,
+		Slug:         postSlug,
+		Status:       status,
+		PublishedAt:  post.PublishedAt,
+		ScheduledFor: post.ScheduledFor,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
 	}
 	
-	createdPost, err := d.repo.CreatePost(ctx, params)
+	createdPost, err := repo.CreatePost(ctx, params)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+
+	// A slug that was given takes over from any redirect using it
+	if _, err := repo.DeleteRedirect(ctx, postSlug.String); err != nil {
 		return nil, err
 	}
 	
@@ -83,7 +256,7 @@ func (d *Database) GetPostByID(ctx context.Context, id int) (*Post, error) {
 	post, err := d.repo.GetPostByID(ctx, int64(id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("post not found")
+			return nil, ErrPostNotFound
 		}
 		return nil, err
 	}
@@ -91,90 +264,226 @@ func (d *Database) GetPostByID(ctx context.Context, id int) (*Post, error) {
 	return &post, nil
 }
 
-// GetPostBySlug retrieves a post by its slug
-func (d *Database) GetPostBySlug(ctx context.Context, slug string) (*Post, error) {
-	post, err := d.repo.GetPostBySlug(ctx, sql.NullString{String: slug, Valid: true})
+// GetPostBySlug retrieves a post by its slug. A slug the post used before it
+// was renamed is followed through its redirect, and redirected reports that
+// it was
+func (d *Database) GetPostBySlug(ctx context.Context, slug string) (post *Post, redirected bool, err error) {
+	found, err := d.repo.GetPostBySlug(ctx, sql.NullString{String: slug, Valid: true})
+	if err == nil {
+		return &found, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	redirect, err := d.repo.GetRedirect(ctx, slug)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("post not found")
+			return nil, false, ErrPostNotFound
 		}
-		return nil, err
+		return nil, false, err
 	}
-	
-	return &post, nil
+
+	post, err = d.GetPostByID(ctx, int(redirect.PostID))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return post, true, nil
 }
 
-// UpdatePostByID updates a post by its ID
+// UpdatePostByID updates a post by its ID, recording the previous version as a revision
 func (d *Database) UpdatePostByID(ctx context.Context, id int, updates Post) (*Post, error) {
-	now := time.Now()
-	
-	params := repository.UpdatePostByIDParams{
-		ID:        int64(id),
-		Title:     updates.Title,
-		Content:   updates.Content,
-		Author:    updates.Author,
-		UpdatedAt: sql.NullTime{Time: now, Valid: true},
+	return d.updatePost(ctx, func(repo *repository.Queries) (Post, error) {
+		return repo.GetPostByID(ctx, int64(id))
+	}, updates)
+}
+
+// UpdatePostBySlug updates a post by its slug, recording the previous version as a revision
+func (d *Database) UpdatePostBySlug(ctx context.Context, slug string, updates Post) (*Post, error) {
+	return d.updatePost(ctx, func(repo *repository.Queries) (Post, error) {
+		return repo.GetPostBySlug(ctx, sql.NullString{String: slug, Valid: true})
+	}, updates)
+}
+
+// UpdatePostWithTags updates a post by its ID the way UpdatePostByID does and,
+// in the same transaction, moves it to updates.Status and replaces its tags.
+// The status is left alone when it is empty or unchanged, and the tags when
+// tags is nil. Either everything is saved or, on error, nothing is. The post
+// is returned along with its tags
+func (d *Database) UpdatePostWithTags(ctx context.Context, id int, updates Post, tags []string) (*Post, []Tag, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, nil, err
 	}
-	
-	updatedPost, err := d.repo.UpdatePostByID(ctx, params)
+	defer tx.Rollback()
+
+	repo := d.withTx(tx)
+
+	existing, err := repo.GetPostByID(ctx, int64(id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("post not found")
+			return nil, nil, ErrPostNotFound
 		}
+		return nil, nil, err
+	}
+
+	updated, err := updatePost(ctx, repo, existing, updates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if updates.Status != "" && updates.Status != existing.Status {
+		status, err := ParsePostStatus(updates.Status)
+		if err != nil {
+			return nil, nil, err
+		}
+		updated, err = setPostStatus(ctx, repo, *updated, status, updates.ScheduledFor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if tags != nil {
+		if _, err := setPostTags(ctx, repo, id, tags); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	postTags, err := repo.ListTagsForPost(ctx, int64(id))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return updated, postTags, nil
+}
+
+// updatePost updates the post that get finds in a transaction of its own
+func (d *Database) updatePost(ctx context.Context, get func(*repository.Queries) (Post, error), updates Post) (*Post, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
 		return nil, err
 	}
-	
-	return &updatedPost, nil
+	defer tx.Rollback()
+
+	repo := d.withTx(tx)
+
+	existing, err := get(repo)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	updated, err := updatePost(ctx, repo, existing, updates)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
-// UpdatePostBySlug updates a post by its slug
-func (d *Database) UpdatePostBySlug(ctx context.Context, slug string, updates Post) (*Post, error) {
+// updatePost saves the title, content, author and slug of updates over an
+// existing post with repo. The slug is only changed when updates has one, and
+// the old slug then redirects to the post
+func updatePost(ctx context.Context, repo *repository.Queries, existing, updates Post) (*Post, error) {
+	newSlug := existing.Slug
+	if updates.Slug.Valid && updates.Slug.String != "" && updates.Slug != existing.Slug {
+		if err := slug.Validate(updates.Slug.String); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidSlug, err)
+		}
+		newSlug = updates.Slug
+	}
+	updates.Slug = newSlug
+
+	authorID, author, err := linkAuthor(ctx, repo, updates.Author)
+	if err != nil {
+		return nil, err
+	}
+	updates.Author = author
+
+	if err := recordRevision(ctx, repo, existing, updates); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	
-	params := repository.UpdatePostBySlugParams{
-		Slug:      sql.NullString{String: slug, Valid: true},
+	params := repository.UpdatePostByIDParams{
+		ID:        existing.ID,
 		Title:     updates.Title,
 		Content:   updates.Content,
 		Author:    updates.Author,
+		AuthorID:  authorID,
+		Slug:      newSlug,
 		UpdatedAt: sql.NullTime{Time: now, Valid: true},
 	}
 	
-	updatedPost, err := d.repo.UpdatePostBySlug(ctx, params)
+	updatedPost, err := repo.UpdatePostByID(ctx, params)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, errors.New("post not found")
+		if isUniqueViolation(err) {
+			return nil, ErrSlugTaken
 		}
 		return nil, err
 	}
-	
+
+	if newSlug != existing.Slug {
+		if err := recordSlugChange(ctx, repo, existing.ID, existing.Slug.String, newSlug.String); err != nil {
+			return nil, err
+		}
+	}
+
 	return &updatedPost, nil
 }
 
-// DeletePostByID deletes a post by its ID
+// DeletePostByID moves a post to the trash by its ID
 func (d *Database) DeletePostByID(ctx context.Context, id int) error {
-	err := d.repo.DeletePostByID(ctx, int64(id))
+	params := repository.DeletePostByIDParams{
+		ID:        int64(id),
+		DeletedAt: sql.NullTime{Time: time.Now().UTC(), Valid: true},
+	}
+
+	rows, err := d.repo.DeletePostByID(ctx, params)
 	if err != nil {
 		return err
 	}
-	
-	// Note: SQLc doesn't automatically check rows affected for :exec queries
-	// You might want to use :execrows if you need to check affected rows
+
+	if rows == 0 {
+		return ErrPostNotFound
+	}
+
 	return nil
 }
 
-// DeletePostBySlug deletes a post by its slug
+// DeletePostBySlug moves a post to the trash by its slug
 func (d *Database) DeletePostBySlug(ctx context.Context, slug string) error {
-	err := d.repo.DeletePostBySlug(ctx, sql.NullString{String: slug, Valid: true})
+	params := repository.DeletePostBySlugParams{
+		Slug:      sql.NullString{String: slug, Valid: true},
+		DeletedAt: sql.NullTime{Time: time.Now().UTC(), Valid: true},
+	}
+
+	rows, err := d.repo.DeletePostBySlug(ctx, params)
 	if err != nil {
 		return err
 	}
-	
-	// Note: SQLc doesn't automatically check rows affected for :exec queries
-	// You might want to use :execrows if you need to check affected rows
+
+	if rows == 0 {
+		return ErrPostNotFound
+	}
+
 	return nil
 }
 
-// ListPosts retrieves all posts with optional limit and offset for pagination
+// ListPosts retrieves all posts, newest first as DefaultPostSort orders them,
+// with optional limit and offset for pagination
 func (d *Database) ListPosts(ctx context.Context, limit, offset int) ([]*Post, error) {
 	if limit > 0 {
 		// Use pagination query
@@ -187,12 +496,7 @@ func (d *Database) ListPosts(ctx context.Context, limit, offset int) ([]*Post, e
 			return nil, err
 		}
 		
-		// Convert to pointer slice
-		result := make([]*Post, len(posts))
-		for i := range posts {
-			result[i] = &posts[i]
-		}
-		return result, nil
+		return toPostPointers(posts), nil
 	}
 	
 	// Use simple list query (no pagination)
@@ -201,10 +505,14 @@ func (d *Database) ListPosts(ctx context.Context, limit, offset int) ([]*Post, e
 		return nil, err
 	}
 	
-	// Convert to pointer slice
+	return toPostPointers(posts), nil
+}
+
+// toPostPointers converts a slice of posts into a slice of post pointers
+func toPostPointers(posts []Post) []*Post {
 	result := make([]*Post, len(posts))
 	for i := range posts {
 		result[i] = &posts[i]
 	}
-	return result, nil
-}
\ No newline at end of file
+	return result
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+var (
+	// ErrPostNotFound is returned when a post does not exist or is in the trash
+	ErrPostNotFound = errors.New("post not found")
+
+	// ErrTagNotFound is returned when a tag does not exist
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrRevisionNotFound is returned when a post revision does not exist
+	ErrRevisionNotFound = errors.New("revision not found")
+
+	// ErrSlugTaken is returned when another post already uses a slug
+	ErrSlugTaken = errors.New("slug already in use")
+
+	// ErrDirtySchema is returned when a migration failed part way through and
+	// the schema needs fixing before migrations can run again
+	ErrDirtySchema = errors.New("database schema is dirty")
+
+	// ErrMigrationsPending is returned when the schema is older than the
+	// embedded migrations and migrating automatically is turned off
+	ErrMigrationsPending = errors.New("database has pending migrations")
+
+	// ErrUnsupportedDriver is returned for operations the database's driver
+	// doesn't support
+	ErrUnsupportedDriver = errors.New("not supported for this database")
+
+	// ErrInvalidBackup is returned when a backup can't be restored
+	ErrInvalidBackup = errors.New("invalid backup")
+
+	// ErrInvalidSlug is returned when a post is given a slug that can't be used
+	ErrInvalidSlug = errors.New("invalid slug")
+
+	// ErrRedirectNotFound is returned when no redirect exists from a slug
+	ErrRedirectNotFound = errors.New("redirect not found")
+
+	// ErrRedirectExists is returned when a slug already redirects to a post
+	ErrRedirectExists = errors.New("a redirect from this slug already exists")
+
+	// ErrAuthorNotFound is returned when an author does not exist
+	ErrAuthorNotFound = errors.New("author not found")
+
+	// ErrAuthorExists is returned when another author already has a name
+	ErrAuthorExists = errors.New("author already exists")
+
+	// ErrAuthorHasPosts is returned when deleting an author who still has posts
+	ErrAuthorHasPosts = errors.New("author has posts")
+
+	// ErrMediaNotFound is returned when no media file matches a reference
+	ErrMediaNotFound = errors.New("media not found")
+
+	// ErrAmbiguousMedia is returned when a reference matches more than one
+	// media file
+	ErrAmbiguousMedia = errors.New("media reference is ambiguous")
+
+	// ErrSearchUnavailable is returned when searching a SQLite database with a
+	// build of cms that doesn't include FTS5
+	ErrSearchUnavailable = errors.New("search is unavailable: cms was built without SQLite FTS5, rebuild it with -tags sqlite_fts5")
+)
+
+// uniqueViolation is the PostgreSQL error code for a UNIQUE constraint violation
+const uniqueViolation = "23505"
+
+// isUniqueViolation reports whether err was caused by a UNIQUE constraint
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+	}
+
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
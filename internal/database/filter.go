@@ -0,0 +1,292 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PostSortField is a field posts can be sorted by
+type PostSortField string
+
+const (
+	SortByID        PostSortField = "id"
+	SortByTitle     PostSortField = "title"
+	SortByAuthor    PostSortField = "author"
+	SortBySlug      PostSortField = "slug"
+	SortByStatus    PostSortField = "status"
+	SortByCreated   PostSortField = "created"
+	SortByUpdated   PostSortField = "updated"
+	SortByPublished PostSortField = "published"
+)
+
+// PostSortFields lists every field posts can be sorted by
+var PostSortFields = []PostSortField{
+	SortByID,
+	SortByTitle,
+	SortByAuthor,
+	SortBySlug,
+	SortByStatus,
+	SortByCreated,
+	SortByUpdated,
+	SortByPublished,
+}
+
+// postSortColumns maps each sort field to the column it sorts by
+var postSortColumns = map[PostSortField]string{
+	SortByID:        "posts.id",
+	SortByTitle:     "LOWER(posts.title)",
+	SortByAuthor:    "LOWER(posts.author)",
+	SortBySlug:      "posts.slug",
+	SortByStatus:    "posts.status",
+	SortByCreated:   "posts.created_at",
+	SortByUpdated:   "posts.updated_at",
+	SortByPublished: "posts.published_at",
+}
+
+// PostSort is the order to list posts in
+type PostSort struct {
+	Field PostSortField
+	Desc  bool
+}
+
+// DefaultPostSort lists the newest posts first
+var DefaultPostSort = PostSort{Field: SortByCreated, Desc: true}
+
+// ParsePostSort parses a sort order written as field[:asc|desc], such as
+// "title" or "created:desc". The direction defaults to ascending
+func ParsePostSort(s string) (PostSort, error) {
+	field, direction, _ := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+
+	sort := PostSort{Field: PostSortField(field)}
+	if _, ok := postSortColumns[sort.Field]; !ok {
+		names := make([]string, len(PostSortFields))
+		for i, field := range PostSortFields {
+			names[i] = string(field)
+		}
+		return PostSort{}, fmt.Errorf("invalid sort field %q (must be one of %s)", field, strings.Join(names, ", "))
+	}
+
+	switch direction {
+	case "", "asc":
+	case "desc":
+		sort.Desc = true
+	default:
+		return PostSort{}, fmt.Errorf("invalid sort direction %q (must be asc or desc)", direction)
+	}
+
+	return sort, nil
+}
+
+// String formats the sort order the way ParsePostSort reads it
+func (s PostSort) String() string {
+	if s.Desc {
+		return string(s.Field) + ":desc"
+	}
+	return string(s.Field) + ":asc"
+}
+
+// PostFilter narrows down and orders the posts listed by ListPostsFiltered.
+// Zero values don't filter anything, and the filters that are set must all
+// match
+type PostFilter struct {
+	Status PostStatus
+	// Tag is normalized the same way tags are when they are set
+	Tag string
+	// Author matches the author's name exactly, ignoring case
+	Author string
+	// Since and Until only keep posts created at or after Since and before
+	// Until
+	Since time.Time
+	Until time.Time
+	// TitleContains matches part of the title, ignoring case
+	TitleContains string
+	// HasSlug keeps only posts with a slug when true and only posts without
+	// one when false
+	HasSlug *bool
+
+	// Sort defaults to DefaultPostSort
+	Sort PostSort
+	// Limit is the most posts to return, or every post when it isn't greater
+	// than zero. Offset skips that many posts first
+	Limit  int
+	Offset int
+}
+
+// postColumns are the columns of a Post, in the order ListPostsFiltered scans
+// them
+const postColumns = `posts.id, posts.title, posts.content, posts.author, posts.slug, posts.created_at,
+       posts.updated_at, posts.status, posts.published_at, posts.scheduled_for, posts.deleted_at,
+       posts.author_id`
+
+// postQuery builds a query over posts a condition at a time, for listings
+// whose filters are only known at runtime and so can't be written out for
+// sqlc. Conditions use ? placeholders, which postgresConn rebinds
+type postQuery struct {
+	driver     Driver
+	conditions []string
+	args       []any
+}
+
+// newPostQuery starts a query over the posts that aren't in the trash
+func newPostQuery(driver Driver) *postQuery {
+	q := &postQuery{driver: driver}
+	return q.where("posts.deleted_at IS NULL")
+}
+
+// where adds a condition that rows must match, along with its arguments
+func (q *postQuery) where(condition string, args ...any) *postQuery {
+	q.conditions = append(q.conditions, condition)
+	q.args = append(q.args, args...)
+	return q
+}
+
+// whereTime adds a condition comparing a timestamp column with t. SQLite keeps
+// timestamps as text, which only compares correctly within one time zone, so
+// both sides are converted to Julian days there
+func (q *postQuery) whereTime(column, op string, t time.Time) *postQuery {
+	if q.driver == DriverPostgres {
+		return q.where(fmt.Sprintf("%s %s ?", column, op), t.UTC())
+	}
+	return q.where(fmt.Sprintf("julianday(%s) %s julianday(?)", column, op), t.UTC())
+}
+
+// filter adds the conditions of a PostFilter
+func (q *postQuery) filter(f PostFilter) *postQuery {
+	if f.Status != "" {
+		q.where("posts.status = ?", string(f.Status))
+	}
+	if f.Tag != "" {
+		q.where(`EXISTS (
+    SELECT 1 FROM post_tags
+    JOIN tags ON tags.id = post_tags.tag_id
+    WHERE post_tags.post_id = posts.id AND tags.name = ?
+)`, NormalizeTagName(f.Tag))
+	}
+	if author := strings.TrimSpace(f.Author); author != "" {
+		q.where("LOWER(posts.author) = LOWER(?)", author)
+	}
+	if !f.Since.IsZero() {
+		q.whereTime("posts.created_at", ">=", f.Since)
+	}
+	if !f.Until.IsZero() {
+		q.whereTime("posts.created_at", "<", f.Until)
+	}
+	if f.TitleContains != "" {
+		q.where(`LOWER(posts.title) LIKE ? ESCAPE '\'`, "%"+escapeLike(strings.ToLower(f.TitleContains))+"%")
+	}
+	if f.HasSlug != nil {
+		if *f.HasSlug {
+			q.where("posts.slug IS NOT NULL AND posts.slug <> ''")
+		} else {
+			q.where("(posts.slug IS NULL OR posts.slug = '')")
+		}
+	}
+	return q
+}
+
+// from returns the FROM and WHERE clauses shared by the query's listing and
+// count
+func (q *postQuery) from() string {
+	return "FROM posts WHERE " + strings.Join(q.conditions, " AND ")
+}
+
+// count returns a query counting the rows that match
+func (q *postQuery) count() (string, []any) {
+	return "SELECT COUNT(*) " + q.from(), q.args
+}
+
+// list returns a query selecting a page of the rows that match, in order.
+// Posts missing the sort field come last either way, and ties are broken by
+// ID so that pages don't overlap
+func (q *postQuery) list(sort PostSort, limit, offset int) (string, []any) {
+	column, ok := postSortColumns[sort.Field]
+	if !ok {
+		column = postSortColumns[DefaultPostSort.Field]
+		sort = DefaultPostSort
+	}
+
+	direction := "ASC"
+	if sort.Desc {
+		direction = "DESC"
+	}
+
+	query := fmt.Sprintf("SELECT %s\n%s\nORDER BY %s IS NULL, %s %s, posts.id %s",
+		postColumns, q.from(), column, column, direction, direction)
+	args := append([]any{}, q.args...)
+
+	switch {
+	case limit > 0:
+		query += "\nLIMIT ? OFFSET ?"
+		args = append(args, limit, offset)
+	case offset > 0 && q.driver == DriverPostgres:
+		query += "\nOFFSET ?"
+		args = append(args, offset)
+	case offset > 0:
+		// SQLite only takes an offset along with a limit
+		query += "\nLIMIT -1 OFFSET ?"
+		args = append(args, offset)
+	}
+
+	return query, args
+}
+
+// ListPostsFiltered retrieves the posts matching a filter, in the filter's
+// order, along with how many posts match in total regardless of its limit and
+// offset
+func (d *Database) ListPostsFiltered(ctx context.Context, filter PostFilter) ([]*Post, int64, error) {
+	if filter.Sort.Field == "" {
+		filter.Sort = DefaultPostSort
+	}
+
+	q := newPostQuery(d.driver).filter(filter)
+	conn := d.conn(d.db)
+
+	var total int64
+	countQuery, countArgs := q.count()
+	if err := conn.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	listQuery, listArgs := q.list(filter.Sort, filter.Limit, filter.Offset)
+	rows, err := conn.QueryContext(ctx, listQuery, listArgs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var posts []*Post
+	for rows.Next() {
+		var post Post
+		err := rows.Scan(
+			&post.ID,
+			&post.Title,
+			&post.Content,
+			&post.Author,
+			&post.Slug,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+			&post.Status,
+			&post.PublishedAt,
+			&post.ScheduledFor,
+			&post.DeletedAt,
+			&post.AuthorID,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		posts = append(posts, &post)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return posts, total, nil
+}
+
+// escapeLike escapes the wildcards in s so that a LIKE pattern matches it
+// literally, using \ as the escape character
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,102 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+)
+
+// Media is an alias for the generated repository type describing a file in
+// the media library
+type Media = repository.Medium
+
+// AddMedia records a file in the media library. Files are identified by their
+// hash, so adding one that is already recorded returns the existing record,
+// along with true
+func (d *Database) AddMedia(ctx context.Context, m Media) (*Media, bool, error) {
+	existing, err := d.repo.GetMediaByHash(ctx, m.Hash)
+	if err == nil {
+		return &existing, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	created, err := d.repo.CreateMedia(ctx, repository.CreateMediaParams{
+		Hash:      m.Hash,
+		Filename:  m.Filename,
+		MimeType:  m.MimeType,
+		Size:      m.Size,
+		Width:     m.Width,
+		Height:    m.Height,
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &created, false, nil
+}
+
+// GetMedia finds the file a reference refers to. References are a prefix of
+// the file's hash, optionally after media:, and must match exactly one file
+func (d *Database) GetMedia(ctx context.Context, ref string) (*Media, error) {
+	prefix, err := media.ParseRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := d.repo.ListMediaByHashPrefix(ctx, prefix+"%")
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", ErrMediaNotFound, ref)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %s matches %d files", ErrAmbiguousMedia, ref, len(matches))
+	}
+}
+
+// ListMedia retrieves every file in the media library, newest first
+func (d *Database) ListMedia(ctx context.Context) ([]Media, error) {
+	return d.repo.ListMedia(ctx)
+}
+
+// DeleteMedia removes the record of the file with a hash
+func (d *Database) DeleteMedia(ctx context.Context, hash string) error {
+	rows, err := d.repo.DeleteMedia(ctx, hash)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrMediaNotFound
+	}
+
+	return nil
+}
+
+// ListPostContents retrieves the content of every post, including posts in
+// the trash, and of every revision, which is everything that can refer to
+// media
+func (d *Database) ListPostContents(ctx context.Context) ([]string, error) {
+	contents, err := d.repo.ListPostContents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, len(contents))
+	for i, content := range contents {
+		result[i] = content.String
+	}
+	return result, nil
+}
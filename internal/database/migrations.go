@@ -4,35 +4,251 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
 )
 
-//go:embed migrations/*.sql
+// migrationFS holds a directory of migrations for each driver
+//
+//go:embed migrations/sqlite/*.sql migrations/postgres/*.sql
 var migrationFS embed.FS
 
-// performMigrations runs database migrations to ensure schema is up to date
-func performMigrations(databaseURL string) error {
+// Migration is one of the embedded schema migrations
+type Migration struct {
+	Version uint   `json:"version" yaml:"version"`
+	Name    string `json:"name" yaml:"name"`
+	Applied bool   `json:"applied" yaml:"applied"`
+}
+
+// MigrationStatus describes the schema version of a database
+type MigrationStatus struct {
+	// Version is the last applied migration, 0 when none have been applied
+	Version uint
+	// Dirty is set when a migration failed part way through
+	Dirty      bool
+	Migrations []Migration
+}
+
+// Pending returns the migrations that haven't been applied yet
+func (s *MigrationStatus) Pending() []Migration {
+	var pending []Migration
+	for _, migration := range s.Migrations {
+		if !migration.Applied {
+			pending = append(pending, migration)
+		}
+	}
+	return pending
+}
+
+// Migrator applies the embedded migrations to a database
+type Migrator struct {
+	m          *migrate.Migrate
+	migrations []Migration
+}
+
+// NewMigrator creates a Migrator for the database at databaseURL, picking the
+// migrations for its driver. It doesn't change the schema
+func NewMigrator(databaseURL string) (*Migrator, error) {
+	driver, dsn, err := ParseURL(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	return newMigrator(driver, dsn)
+}
+
+func newMigrator(driver Driver, dsn string) (*Migrator, error) {
+	dir := "migrations/" + string(driver)
+
+	migrations, err := embeddedMigrations(dir)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create source from embedded filesystem
-	source, err := iofs.New(migrationFS, "migrations")
+	source, err := iofs.New(migrationFS, dir)
 	if err != nil {
-		return fmt.Errorf("failed to create migration source: %w", err)
+		return nil, fmt.Errorf("failed to create migration source: %w", err)
+	}
+
+	migrateURL := dsn
+	if driver == DriverSQLite {
+		migrateURL = "sqlite3://" + dsn
 	}
 
 	// Create migrator instance
-	m, err := migrate.NewWithSourceInstance("iofs", source, "sqlite3://"+databaseURL)
+	m, err := migrate.NewWithSourceInstance("iofs", source, migrateURL)
 	if err != nil {
-		return fmt.Errorf("failed to create migrator: %w", err)
+		return nil, fmt.Errorf("failed to create migrator: %w", err)
+	}
+
+	return &Migrator{m: m, migrations: migrations}, nil
+}
+
+// Close releases the migrator's database connection
+func (m *Migrator) Close() error {
+	sourceErr, dbErr := m.m.Close()
+	return errors.Join(sourceErr, dbErr)
+}
+
+// Latest returns the version of the newest embedded migration
+func (m *Migrator) Latest() uint {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the last applied migration and whether it failed part way.
+// The version is 0 when no migrations have been applied
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+// Status reports the schema version and which migrations have been applied
+func (m *Migrator) Status() (*MigrationStatus, error) {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	status := &MigrationStatus{
+		Version:    version,
+		Dirty:      dirty,
+		Migrations: make([]Migration, len(m.migrations)),
+	}
+	for i, migration := range m.migrations {
+		// A dirty version failed part way, so it doesn't count as applied
+		migration.Applied = migration.Version < version || (migration.Version == version && !dirty)
+		status.Migrations[i] = migration
+	}
+
+	return status, nil
+}
+
+// Up applies up to steps pending migrations, or all of them when steps is 0
+func (m *Migrator) Up(steps int) error {
+	if steps < 0 {
+		return errors.New("number of migrations must be positive")
 	}
-	defer m.Close()
 
-	// Run up migrations
-	err = m.Up()
-	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
-		return fmt.Errorf("failed to run up migrations: %w", err)
+	var err error
+	if steps == 0 {
+		err = m.m.Up()
+	} else {
+		err = m.m.Steps(steps)
+	}
+	return migrationError("apply", err)
+}
+
+// Down rolls back the last steps applied migrations
+func (m *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return errors.New("number of migrations must be positive")
+	}
+	return migrationError("roll back", m.m.Steps(-steps))
+}
+
+// Force sets the schema version without running any migrations and clears the
+// dirty flag. It is used to recover after a migration failed part way, once
+// the schema has been fixed by hand. Version 0 means no migrations applied
+func (m *Migrator) Force(version uint) error {
+	if version > m.Latest() {
+		return fmt.Errorf("unknown migration version %d (latest is %d)", version, m.Latest())
+	}
+
+	// golang-migrate uses -1 for "no version"
+	v := int(version)
+	if version == 0 {
+		v = database.NilVersion
+	}
+
+	if err := m.m.Force(v); err != nil {
+		return fmt.Errorf("failed to force version %d: %w", version, err)
+	}
+	return nil
+}
+
+// CheckCurrent returns an error unless every embedded migration has been
+// applied cleanly
+func (m *Migrator) CheckCurrent() error {
+	version, dirty, err := m.Version()
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("%w at version %d", ErrDirtySchema, version)
+	}
+
+	if version < m.Latest() {
+		return fmt.Errorf("%w: schema is at version %d, latest is %d", ErrMigrationsPending, version, m.Latest())
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// migrationError turns the results golang-migrate reports as errors but aren't
+// failures into nil, and explains dirty databases
+func migrationError(action string, err error) error {
+	var shortLimit migrate.ErrShortLimit
+	var dirty migrate.ErrDirty
+
+	switch {
+	case err == nil, errors.Is(err, migrate.ErrNoChange), errors.As(err, &shortLimit):
+		return nil
+	case errors.As(err, &dirty):
+		return fmt.Errorf("%w at version %d", ErrDirtySchema, dirty.Version)
+	}
+
+	return fmt.Errorf("failed to %s migrations: %w", action, err)
+}
+
+// embeddedMigrations lists the migrations in dir, oldest first
+func embeddedMigrations(dir string) ([]Migration, error) {
+	matches, err := fs.Glob(migrationFS, dir+"/*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	for _, match := range matches {
+		name := strings.TrimSuffix(path.Base(match), ".up.sql")
+
+		number, title, found := strings.Cut(name, "_")
+		if !found {
+			return nil, fmt.Errorf("invalid migration file name %s", match)
+		}
+
+		version, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name %s: %w", match, err)
+		}
+
+		migrations = append(migrations, Migration{
+			Version: uint(version),
+			Name:    title,
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_posts_status;
+ALTER TABLE posts DROP COLUMN scheduled_for;
+ALTER TABLE posts DROP COLUMN published_at;
+ALTER TABLE posts DROP COLUMN status;
@@ -0,0 +1,8 @@
+ALTER TABLE posts ADD COLUMN status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'scheduled', 'archived'));
+ALTER TABLE posts ADD COLUMN published_at TIMESTAMPTZ;
+ALTER TABLE posts ADD COLUMN scheduled_for TIMESTAMPTZ;
+
+-- Posts created before statuses existed were live, so treat them as published
+UPDATE posts SET status = 'published', published_at = created_at;
+
+CREATE INDEX idx_posts_status ON posts (status);
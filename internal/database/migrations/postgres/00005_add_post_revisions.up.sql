@@ -0,0 +1,11 @@
+CREATE TABLE post_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    post_id BIGINT NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title TEXT NOT NULL,
+    content TEXT,
+    author TEXT,
+    slug TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (post_id, revision)
+);
@@ -0,0 +1,7 @@
+-- Must match postgresSearchDocument in internal/database/search.go for the
+-- index to be used
+CREATE INDEX idx_posts_search ON posts USING GIN ((
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(author, '')), 'B')
+));
@@ -0,0 +1,8 @@
+CREATE TABLE redirects (
+    id BIGSERIAL PRIMARY KEY,
+    from_slug TEXT NOT NULL UNIQUE,
+    post_id BIGINT NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_redirects_post_id ON redirects (post_id);
@@ -0,0 +1,28 @@
+CREATE TABLE authors (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    email TEXT,
+    bio TEXT,
+    avatar_url TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_authors_name ON authors (LOWER(name));
+
+-- One author per distinct name, ignoring case and surrounding whitespace
+INSERT INTO authors (name)
+SELECT MIN(TRIM(author)) FROM posts
+WHERE author IS NOT NULL AND TRIM(author) <> ''
+GROUP BY LOWER(TRIM(author));
+
+-- posts.author keeps the author's name for display and search
+ALTER TABLE posts ADD COLUMN author_id BIGINT REFERENCES authors (id) ON DELETE SET NULL;
+
+UPDATE posts SET
+    author_id = authors.id,
+    author = authors.name
+FROM authors
+WHERE LOWER(authors.name) = LOWER(TRIM(posts.author));
+
+CREATE INDEX idx_posts_author_id ON posts (author_id);
@@ -0,0 +1,10 @@
+CREATE TABLE media (
+    id BIGSERIAL PRIMARY KEY,
+    hash TEXT NOT NULL UNIQUE,
+    filename TEXT NOT NULL,
+    mime_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    width INTEGER,
+    height INTEGER,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_posts_search;
+CREATE INDEX idx_posts_search ON posts USING GIN ((
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(author, '')), 'B')
+));
@@ -0,0 +1,9 @@
+-- Authors get their own weight so searches can be scoped to them with
+-- author:name. Must match postgresSearchDocument in
+-- internal/database/search.go for the index to be used
+DROP INDEX IF EXISTS idx_posts_search;
+CREATE INDEX idx_posts_search ON posts USING GIN ((
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(author, '')), 'C')
+));
@@ -0,0 +1 @@
+DELETE FROM posts WHERE slug IN ('welcome-to-cms', 'getting-started');
\ No newline at end of file
@@ -0,0 +1,3 @@
+INSERT INTO posts (title, slug, content, author) VALUES 
+('Welcome to our CMS', 'welcome-to-cms', 'This is the first post in our CMS system. Welcome to the future of content management!', 'CMS Admin'),
+('Getting Started Guide', 'getting-started', 'Here''s how to get started with our amazing CMS. Follow these simple steps to create your first post.', 'Documentation Team');
\ No newline at end of file
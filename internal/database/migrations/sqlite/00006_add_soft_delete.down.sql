@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_posts_deleted_at;
+DELETE FROM posts WHERE deleted_at IS NOT NULL;
+ALTER TABLE posts DROP COLUMN deleted_at;
@@ -0,0 +1,2 @@
+-- The posts_fts search index is managed by ensureSearchIndex in
+-- internal/database/search.go
@@ -0,0 +1,3 @@
+-- The posts_fts search index needs FTS5, which SQLite may be built without, so
+-- it is created when the database is opened instead: see ensureSearchIndex in
+-- internal/database/search.go
@@ -0,0 +1,8 @@
+CREATE TABLE redirects (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    from_slug TEXT NOT NULL UNIQUE,
+    post_id INTEGER NOT NULL REFERENCES posts (id) ON DELETE CASCADE,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_redirects_post_id ON redirects (post_id);
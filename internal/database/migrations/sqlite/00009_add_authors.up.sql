@@ -0,0 +1,25 @@
+CREATE TABLE authors (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    email TEXT,
+    bio TEXT,
+    avatar_url TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One author per distinct name, ignoring case and surrounding whitespace
+INSERT INTO authors (name)
+SELECT MIN(TRIM(author)) FROM posts
+WHERE author IS NOT NULL AND TRIM(author) <> ''
+GROUP BY LOWER(TRIM(author));
+
+-- posts.author keeps the author's name for display and search
+ALTER TABLE posts ADD COLUMN author_id INTEGER REFERENCES authors (id) ON DELETE SET NULL;
+
+UPDATE posts SET
+    author_id = (SELECT authors.id FROM authors WHERE LOWER(authors.name) = LOWER(TRIM(posts.author))),
+    author = (SELECT authors.name FROM authors WHERE LOWER(authors.name) = LOWER(TRIM(posts.author)))
+WHERE author IS NOT NULL AND TRIM(author) <> '';
+
+CREATE INDEX idx_posts_author_id ON posts (author_id);
@@ -0,0 +1,10 @@
+CREATE TABLE media (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    hash TEXT NOT NULL UNIQUE,
+    filename TEXT NOT NULL,
+    mime_type TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    width INTEGER,
+    height INTEGER,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+-- Only the PostgreSQL search index changes in this version. SQLite's posts_fts
+-- already searches each column separately
@@ -0,0 +1,36 @@
+-- name: CreateAuthor :one
+INSERT INTO authors (name, email, bio, avatar_url, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetAuthorByID :one
+SELECT * FROM authors WHERE id = ?;
+
+-- name: GetAuthorByName :one
+SELECT * FROM authors WHERE LOWER(name) = LOWER(sqlc.arg(name));
+
+-- name: ListAuthors :many
+SELECT authors.id, authors.name, authors.email, authors.bio, authors.avatar_url, authors.created_at, authors.updated_at,
+       COUNT(posts.id) AS post_count
+FROM authors
+LEFT JOIN posts ON posts.author_id = authors.id AND posts.deleted_at IS NULL
+GROUP BY authors.id
+ORDER BY authors.name ASC;
+
+-- name: UpdateAuthor :one
+UPDATE authors
+SET name = ?, email = ?, bio = ?, avatar_url = ?, updated_at = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?;
+
+-- name: CountPostsByAuthor :one
+SELECT COUNT(*) FROM posts WHERE author_id = ?;
+
+-- name: RenamePostsAuthor :exec
+UPDATE posts SET author = ? WHERE author_id = ?;
+
+-- name: ClearPostsAuthor :exec
+UPDATE posts SET author = NULL, author_id = NULL WHERE author_id = ?;
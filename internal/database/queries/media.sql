@@ -0,0 +1,21 @@
+-- name: CreateMedia :one
+INSERT INTO media (hash, filename, mime_type, size, width, height, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetMediaByHash :one
+SELECT * FROM media WHERE hash = ?;
+
+-- name: ListMediaByHashPrefix :many
+SELECT * FROM media WHERE hash LIKE sqlc.arg(pattern) ORDER BY hash ASC;
+
+-- name: ListMedia :many
+SELECT * FROM media ORDER BY created_at DESC, id DESC;
+
+-- name: DeleteMedia :execrows
+DELETE FROM media WHERE hash = ?;
+
+-- name: ListPostContents :many
+SELECT content FROM posts WHERE content IS NOT NULL
+UNION ALL
+SELECT content FROM post_revisions WHERE content IS NOT NULL;
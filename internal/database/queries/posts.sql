@@ -1,36 +1,74 @@
 -- name: ListPosts :many
-SELECT * FROM posts ORDER BY id ASC;
+SELECT * FROM posts WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC;
 
 -- name: GetPostByID :one
-SELECT * FROM posts WHERE id = ?;
+SELECT * FROM posts WHERE id = ? AND deleted_at IS NULL;
 
 -- name: GetPostBySlug :one
-SELECT * FROM posts WHERE slug = ?;
+SELECT * FROM posts WHERE slug = ? AND deleted_at IS NULL;
+
+-- name: CountPostsWithSlug :one
+SELECT COUNT(*) FROM posts WHERE slug = ?;
 
 -- name: CreatePost :one
-INSERT INTO posts (title, content, author, slug, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?)
+INSERT INTO posts (title, content, author, author_id, slug, status, published_at, scheduled_for, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING *;
 
 -- name: UpdatePostByID :one
 UPDATE posts 
-SET title = ?, content = ?, author = ?, updated_at = ?
-WHERE id = ?
+SET title = ?, content = ?, author = ?, author_id = ?, slug = ?, updated_at = ?
+WHERE id = ? AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeletePostByID :execrows
+UPDATE posts SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL;
+
+-- name: DeletePostBySlug :execrows
+UPDATE posts SET deleted_at = ? WHERE slug = ? AND deleted_at IS NULL;
+
+-- name: ListPostsWithPagination :many
+SELECT * FROM posts 
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: ListPostsByStatus :many
+SELECT * FROM posts WHERE status = ? AND deleted_at IS NULL ORDER BY created_at DESC, id DESC;
+
+-- name: ListPostsByStatusWithPagination :many
+SELECT * FROM posts 
+WHERE status = ? AND deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: UpdatePostStatus :one
+UPDATE posts 
+SET status = ?, published_at = ?, scheduled_for = ?, updated_at = ?
+WHERE id = ? AND deleted_at IS NULL
 RETURNING *;
 
--- name: UpdatePostBySlug :one
+-- name: PublishDuePosts :execrows
 UPDATE posts 
-SET title = ?, content = ?, author = ?, updated_at = ?
-WHERE slug = ?
+SET status = 'published', published_at = scheduled_for, updated_at = ?
+WHERE status = 'scheduled' AND scheduled_for <= ? AND deleted_at IS NULL;
+
+-- name: ListTrashedPosts :many
+SELECT * FROM posts WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC;
+
+-- name: RestorePostByID :one
+UPDATE posts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL
 RETURNING *;
 
--- name: DeletePostByID :exec
-DELETE FROM posts WHERE id = ?;
+-- name: RestorePostBySlug :one
+UPDATE posts SET deleted_at = NULL WHERE slug = ? AND deleted_at IS NOT NULL
+RETURNING *;
 
--- name: DeletePostBySlug :exec
-DELETE FROM posts WHERE slug = ?;
+-- name: PurgePostByID :execrows
+DELETE FROM posts WHERE id = ? AND deleted_at IS NOT NULL;
 
--- name: ListPostsWithPagination :many
-SELECT * FROM posts 
-ORDER BY created_at DESC 
-LIMIT ? OFFSET ?;
\ No newline at end of file
+-- name: PurgePostBySlug :execrows
+DELETE FROM posts WHERE slug = ? AND deleted_at IS NOT NULL;
+
+-- name: PurgeTrashedPosts :execrows
+DELETE FROM posts WHERE deleted_at IS NOT NULL AND deleted_at <= ?;
@@ -0,0 +1,17 @@
+-- name: CreateRedirect :one
+INSERT INTO redirects (from_slug, post_id, created_at)
+VALUES (?, ?, ?)
+RETURNING *;
+
+-- name: GetRedirect :one
+SELECT * FROM redirects WHERE from_slug = ?;
+
+-- name: DeleteRedirect :execrows
+DELETE FROM redirects WHERE from_slug = ?;
+
+-- name: ListRedirects :many
+SELECT redirects.id, redirects.from_slug, redirects.post_id, posts.slug AS to_slug, redirects.created_at
+FROM redirects
+JOIN posts ON posts.id = redirects.post_id
+WHERE posts.deleted_at IS NULL
+ORDER BY redirects.from_slug ASC;
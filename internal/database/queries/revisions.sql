@@ -0,0 +1,15 @@
+-- name: CreatePostRevision :one
+INSERT INTO post_revisions (post_id, revision, title, content, author, slug, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+RETURNING *;
+
+-- name: GetNextRevisionNumber :one
+SELECT CAST(COALESCE(MAX(revision), 0) + 1 AS INTEGER) AS next_revision
+FROM post_revisions
+WHERE post_id = ?;
+
+-- name: GetPostRevision :one
+SELECT * FROM post_revisions WHERE post_id = ? AND revision = ?;
+
+-- name: ListPostRevisions :many
+SELECT * FROM post_revisions WHERE post_id = ? ORDER BY revision DESC;
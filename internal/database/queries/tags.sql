@@ -0,0 +1,41 @@
+-- name: CreateTag :one
+INSERT INTO tags (name, created_at)
+VALUES (?, ?)
+RETURNING *;
+
+-- name: GetTagByName :one
+SELECT * FROM tags WHERE name = ?;
+
+-- name: ListTags :many
+SELECT tags.id, tags.name, tags.created_at, COUNT(posts.id) AS post_count
+FROM tags
+LEFT JOIN post_tags ON post_tags.tag_id = tags.id
+LEFT JOIN posts ON posts.id = post_tags.post_id AND posts.deleted_at IS NULL
+GROUP BY tags.id
+ORDER BY tags.name ASC;
+
+-- name: RenameTag :one
+UPDATE tags 
+SET name = ?
+WHERE id = ?
+RETURNING *;
+
+-- name: DeleteTag :exec
+DELETE FROM tags WHERE id = ?;
+
+-- name: AddPostTag :exec
+INSERT INTO post_tags (post_id, tag_id)
+VALUES (?, ?)
+ON CONFLICT DO NOTHING;
+
+-- name: DeletePostTags :exec
+DELETE FROM post_tags WHERE post_id = ?;
+
+-- name: ListTagsForPost :many
+SELECT tags.* FROM tags
+JOIN post_tags ON post_tags.tag_id = tags.id
+WHERE post_tags.post_id = ?
+ORDER BY tags.name ASC;
+
+-- name: ListPostIDsForTag :many
+SELECT post_id FROM post_tags WHERE tag_id = ?;
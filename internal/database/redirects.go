@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+	"github.com/dreamsofcode-io/cli-cms/internal/slug"
+)
+
+// Redirect is an old slug that now leads to a post, along with the post's
+// current slug
+type Redirect = repository.ListRedirectsRow
+
+// ListRedirects retrieves every redirect to a post that isn't in the trash,
+// ordered by the slug redirected from
+func (d *Database) ListRedirects(ctx context.Context) ([]Redirect, error) {
+	return d.repo.ListRedirects(ctx)
+}
+
+// AddRedirect makes from redirect to the post currently at to. from can't be
+// the slug of a post, including one in the trash
+func (d *Database) AddRedirect(ctx context.Context, from, to string) (*Redirect, error) {
+	from = strings.TrimSpace(from)
+	if err := slug.Validate(from); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSlug, err)
+	}
+
+	count, err := d.repo.CountPostsWithSlug(ctx, sql.NullString{String: from, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, fmt.Errorf("%w: a post uses %q", ErrSlugTaken, from)
+	}
+
+	post, _, err := d.GetPostBySlug(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect, err := d.repo.CreateRedirect(ctx, repository.CreateRedirectParams{
+		FromSlug:  from,
+		PostID:    post.ID,
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrRedirectExists
+		}
+		return nil, err
+	}
+
+	return &Redirect{
+		ID:        redirect.ID,
+		FromSlug:  redirect.FromSlug,
+		PostID:    redirect.PostID,
+		ToSlug:    post.Slug,
+		CreatedAt: redirect.CreatedAt,
+	}, nil
+}
+
+// RemoveRedirect deletes the redirect from a slug
+func (d *Database) RemoveRedirect(ctx context.Context, from string) error {
+	rows, err := d.repo.DeleteRedirect(ctx, from)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrRedirectNotFound
+	}
+
+	return nil
+}
+
+// recordSlugChange makes a post's old slug redirect to it once it has been
+// renamed. A redirect from the new slug is removed, as the post now uses it
+func recordSlugChange(ctx context.Context, repo *repository.Queries, postID int64, oldSlug, newSlug string) error {
+	if _, err := repo.DeleteRedirect(ctx, newSlug); err != nil {
+		return err
+	}
+
+	if oldSlug == "" {
+		return nil
+	}
+
+	_, err := repo.CreateRedirect(ctx, repository.CreateRedirectParams{
+		FromSlug:  oldSlug,
+		PostID:    postID,
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	return err
+}
+
+// slugTaken returns a function reporting whether a post, including one in the
+// trash, uses a slug or an old slug redirects from it
+func slugTaken(repo *repository.Queries) func(context.Context, string) (bool, error) {
+	return func(ctx context.Context, s string) (bool, error) {
+		count, err := repo.CountPostsWithSlug(ctx, sql.NullString{String: s, Valid: true})
+		if err != nil || count > 0 {
+			return count > 0, err
+		}
+
+		_, err = repo.GetRedirect(ctx, s)
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+}
@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+)
+
+// PostRevision is an alias for the generated repository PostRevision type
+type PostRevision = repository.PostRevision
+
+// ListPostRevisions retrieves the revisions of a post, newest first
+func (d *Database) ListPostRevisions(ctx context.Context, postID int) ([]PostRevision, error) {
+	return d.repo.ListPostRevisions(ctx, int64(postID))
+}
+
+// GetPostRevision retrieves a single revision of a post
+func (d *Database) GetPostRevision(ctx context.Context, postID, revision int) (*PostRevision, error) {
+	params := repository.GetPostRevisionParams{
+		PostID:   int64(postID),
+		Revision: int64(revision),
+	}
+
+	rev, err := d.repo.GetPostRevision(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, err
+	}
+
+	return &rev, nil
+}
+
+// RestorePostRevision restores a post's title, content, author and slug from a
+// revision. The restore is itself an update, so the current version is kept as
+// a new revision, and the current slug redirects to the restored one if they
+// differ
+func (d *Database) RestorePostRevision(ctx context.Context, postID, revision int) (*Post, error) {
+	rev, err := d.GetPostRevision(ctx, postID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.UpdatePostByID(ctx, postID, Post{
+		Title:   rev.Title,
+		Content: rev.Content,
+		Author:  rev.Author,
+		Slug:    rev.Slug,
+	})
+}
+
+// recordRevision saves the existing version of a post as a new revision, unless the
+// updates leave its title, content, author and slug unchanged
+func recordRevision(ctx context.Context, repo *repository.Queries, existing, updates Post) error {
+	if existing.Title == updates.Title &&
+		existing.Content == updates.Content &&
+		existing.Author == updates.Author &&
+		existing.Slug == updates.Slug {
+		return nil
+	}
+
+	next, err := repo.GetNextRevisionNumber(ctx, existing.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.CreatePostRevision(ctx, repository.CreatePostRevisionParams{
+		PostID:    existing.ID,
+		Revision:  next,
+		Title:     existing.Title,
+		Content:   existing.Content,
+		Author:    existing.Author,
+		Slug:      existing.Slug,
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+	return err
+}
@@ -0,0 +1,386 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Markers wrapped around matching terms in search snippets
+const (
+	SnippetHighlightStart = "<mark>"
+	SnippetHighlightEnd   = "</mark>"
+)
+
+// SearchResult is a post matching a search query along with its relevance
+type SearchResult struct {
+	Post    *Post
+	Rank    float64
+	Snippet string
+}
+
+// searchPosts is written by hand because sqlc can't parse FTS virtual tables.
+// FTS5's bm25 scores better matches lower, so it is negated to rank results
+// the same way as on PostgreSQL. Matches in the title weigh three times as
+// much as those in the content or author. Limit is -1 when there is no limit
+const searchPosts = `
+SELECT posts.id, posts.title, posts.content, posts.author, posts.slug, posts.created_at,
+       posts.updated_at, posts.status, posts.published_at, posts.scheduled_for, posts.deleted_at,
+       posts.author_id,
+       -bm25(posts_fts, 3.0, 1.0, 1.0) AS rank,
+       snippet(posts_fts, -1, ?, ?, '…', 16)
+FROM posts_fts
+JOIN posts ON posts.id = posts_fts.rowid
+WHERE posts_fts MATCH ? AND posts.deleted_at IS NULL
+ORDER BY rank DESC, posts.id ASC
+LIMIT ? OFFSET ?
+`
+
+// searchIndexTriggers are the triggers keeping posts_fts in step with posts
+var searchIndexTriggers = []string{
+	"posts_fts_before_update",
+	"posts_fts_before_delete",
+	"posts_fts_after_update",
+	"posts_fts_after_insert",
+}
+
+// createSearchIndex creates posts_fts and its triggers, then indexes every post
+const createSearchIndex = `
+CREATE VIRTUAL TABLE posts_fts USING fts5(title, content, author, content='posts', content_rowid='id');
+
+CREATE TRIGGER posts_fts_before_update BEFORE UPDATE OF title, content, author ON posts BEGIN
+    INSERT INTO posts_fts (posts_fts, rowid, title, content, author) VALUES ('delete', old.id, old.title, old.content, old.author);
+END;
+
+CREATE TRIGGER posts_fts_before_delete BEFORE DELETE ON posts BEGIN
+    INSERT INTO posts_fts (posts_fts, rowid, title, content, author) VALUES ('delete', old.id, old.title, old.content, old.author);
+END;
+
+CREATE TRIGGER posts_fts_after_update AFTER UPDATE OF title, content, author ON posts BEGIN
+    INSERT INTO posts_fts (rowid, title, content, author) VALUES (new.id, new.title, new.content, new.author);
+END;
+
+CREATE TRIGGER posts_fts_after_insert AFTER INSERT ON posts BEGIN
+    INSERT INTO posts_fts (rowid, title, content, author) VALUES (new.id, new.title, new.content, new.author);
+END;
+
+INSERT INTO posts_fts (posts_fts) VALUES ('rebuild');
+`
+
+// ensureSearchIndex sets up the posts_fts index on SQLite and reports whether
+// search is available. go-sqlite3 only compiles FTS5 in with the sqlite_fts5
+// build tag, so the index is managed here rather than in a migration. Without
+// FTS5 the triggers updating the index are dropped, as they would otherwise
+// fail every write to posts. With FTS5 a missing or incomplete index is
+// created from scratch, which also catches up on posts written while the
+// triggers were gone
+func ensureSearchIndex(ctx context.Context, db *sql.DB) (bool, error) {
+	var fts5 bool
+	if err := db.QueryRowContext(ctx, "SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&fts5); err != nil {
+		return false, err
+	}
+
+	var triggers int
+	err := db.QueryRowContext(ctx, `
+SELECT COUNT(*) FROM sqlite_master
+WHERE type = 'trigger' AND tbl_name = 'posts' AND name LIKE 'posts\_fts\_%' ESCAPE '\'`).Scan(&triggers)
+	if err != nil {
+		return false, err
+	}
+
+	var indexed bool
+	err = db.QueryRowContext(ctx, `
+SELECT COUNT(*) > 0 FROM sqlite_master
+WHERE type = 'table' AND name = 'posts_fts' AND sql LIKE '%USING fts5%'`).Scan(&indexed)
+	if err != nil {
+		return false, err
+	}
+
+	if fts5 && indexed && triggers == len(searchIndexTriggers) {
+		return true, nil
+	}
+	if !fts5 && triggers == 0 {
+		return false, nil
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	for _, trigger := range searchIndexTriggers {
+		if _, err := tx.ExecContext(ctx, "DROP TRIGGER IF EXISTS "+trigger); err != nil {
+			return false, fmt.Errorf("failed to drop search trigger: %w", err)
+		}
+	}
+
+	if fts5 {
+		if _, err := tx.ExecContext(ctx, "DROP TABLE IF EXISTS posts_fts"); err != nil {
+			return false, fmt.Errorf("failed to drop search index: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, createSearchIndex); err != nil {
+			return false, fmt.Errorf("failed to create search index: %w", err)
+		}
+	}
+
+	return fts5, tx.Commit()
+}
+
+// postgresSearchDocument is the weighted text searched on PostgreSQL. Each
+// column gets its own weight so that field filters can match on it. It must
+// match the expression of the idx_posts_search index for the index to be used
+const postgresSearchDocument = `(
+    setweight(to_tsvector('english', coalesce(posts.title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(posts.content, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(posts.author, '')), 'C')
+)`
+
+// searchPostsPostgres ranks and pages matches in the database. The weights,
+// ordered D, C, B, A, rank content and authors the same and titles higher.
+// Limit is NULL when there is no limit
+const searchPostsPostgres = `
+SELECT posts.id, posts.title, posts.content, posts.author, posts.slug, posts.created_at,
+       posts.updated_at, posts.status, posts.published_at, posts.scheduled_for, posts.deleted_at,
+       posts.author_id,
+       ts_rank_cd('{0.1, 0.4, 0.4, 1.0}', ` + postgresSearchDocument + `, query) AS rank,
+       ts_headline('english', coalesce(posts.content, ''), query, $2)
+FROM posts, to_tsquery('english', $1) AS query
+WHERE ` + postgresSearchDocument + ` @@ query AND posts.deleted_at IS NULL
+ORDER BY rank DESC, posts.id ASC
+LIMIT $3 OFFSET $4
+`
+
+// postgresSearchWeights are the weights of the columns in postgresSearchDocument
+var postgresSearchWeights = map[string]string{
+	"title":   "A",
+	"content": "B",
+	"author":  "C",
+}
+
+// postgresTSQuery translates a search query written in the FTS5 syntax that
+// SQLite accepts into the text of a PostgreSQL tsquery. Words and phrases are
+// quoted, prefixes become :*, field filters become the field's weight, and
+// AND, OR and NOT become &, | and !. Words next to each other must all match
+func postgresTSQuery(query string) (string, error) {
+	var b strings.Builder
+
+	// operand is true after a term or closing bracket, where a following term
+	// is joined with an implicit AND
+	operand := false
+	and := func() {
+		if operand {
+			b.WriteString(" & ")
+		}
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '(':
+			and()
+			b.WriteByte('(')
+			operand = false
+			i++
+			continue
+		case c == ')':
+			b.WriteByte(')')
+			operand = true
+			i++
+			continue
+		}
+
+		// An optional field filter, then a phrase or a word
+		field := ""
+		end := i
+		for end < len(query) && isSearchFieldChar(query[end]) {
+			end++
+		}
+		if end > i && end < len(query) && query[end] == ':' {
+			field = query[i:end]
+			if _, ok := postgresSearchWeights[field]; !ok {
+				return "", fmt.Errorf("unknown search field %q (must be title, content or author)", field)
+			}
+			i = end + 1
+		}
+
+		var words []string
+		prefix := false
+		if i < len(query) && query[i] == '"' {
+			end := strings.IndexByte(query[i+1:], '"')
+			if end < 0 {
+				return "", errors.New("unterminated phrase in search query")
+			}
+			words = strings.Fields(query[i+1 : i+1+end])
+			i += end + 2
+
+			// A * after a phrase makes its last word a prefix
+			if i < len(query) && query[i] == '*' {
+				prefix = true
+				i++
+			}
+		} else {
+			end := i
+			for end < len(query) && !strings.ContainsRune(" \t\n\r()\"", rune(query[end])) {
+				end++
+			}
+			word := query[i:end]
+			i = end
+
+			if field == "" {
+				switch word {
+				case "AND":
+					b.WriteString(" & ")
+					operand = false
+					continue
+				case "OR":
+					b.WriteString(" | ")
+					operand = false
+					continue
+				case "NOT":
+					and()
+					b.WriteByte('!')
+					operand = false
+					continue
+				}
+			}
+
+			word, prefix = strings.CutSuffix(word, "*")
+			words = []string{word}
+		}
+		if len(words) == 0 || words[0] == "" {
+			return "", errors.New("search query has an empty term")
+		}
+
+		// Words are quoted so that any punctuation is left to the text parser,
+		// and labelled with the prefix marker and the field's weight
+		weight := postgresSearchWeights[field]
+		and()
+		if len(words) > 1 {
+			b.WriteByte('(')
+		}
+		for j, word := range words {
+			if j > 0 {
+				b.WriteString(" <-> ")
+			}
+			b.WriteString("'" + strings.ReplaceAll(word, "'", "''") + "'")
+
+			wordPrefix := prefix && j == len(words)-1
+			if wordPrefix || weight != "" {
+				b.WriteByte(':')
+				if wordPrefix {
+					b.WriteByte('*')
+				}
+				b.WriteString(weight)
+			}
+		}
+		if len(words) > 1 {
+			b.WriteByte(')')
+		}
+		operand = true
+	}
+
+	return b.String(), nil
+}
+
+// isSearchFieldChar reports whether c can be part of a field name
+func isSearchFieldChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// SearchPosts runs a full-text search over post titles, content and authors.
+// The query supports phrases ("exact words"), prefixes (go*), column filters
+// (title:go, author:jane) and AND/OR/NOT operators on either driver. Results
+// are ordered by relevance and paged with limit and offset when limit is
+// greater than zero
+func (d *Database) SearchPosts(ctx context.Context, query string, limit, offset int) ([]SearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New("search query cannot be empty")
+	}
+
+	if d.driver == DriverPostgres {
+		return d.searchPostsPostgres(ctx, query, limit, offset)
+	}
+	if !d.search {
+		return nil, ErrSearchUnavailable
+	}
+
+	limitArg := -1
+	if limit > 0 {
+		limitArg = limit
+	}
+
+	rows, err := d.db.QueryContext(ctx, searchPosts, SnippetHighlightStart, SnippetHighlightEnd, query, limitArg, offset)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search query %q: %w", query, err)
+	}
+	defer rows.Close()
+
+	return scanSearchResults(rows, query)
+}
+
+func (d *Database) searchPostsPostgres(ctx context.Context, query string, limit, offset int) ([]SearchResult, error) {
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=16, MinWords=8", SnippetHighlightStart, SnippetHighlightEnd)
+
+	var limitArg sql.NullInt64
+	if limit > 0 {
+		limitArg = sql.NullInt64{Int64: int64(limit), Valid: true}
+	}
+
+	tsquery, err := postgresTSQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search query %q: %w", query, err)
+	}
+
+	rows, err := d.db.QueryContext(ctx, searchPostsPostgres, tsquery, headlineOptions, limitArg, offset)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search query %q: %w", query, err)
+	}
+	defer rows.Close()
+
+	return scanSearchResults(rows, query)
+}
+
+// scanSearchResults reads the rows of either driver's search query. Both
+// report a bad query while the rows are read as well as when it runs
+func scanSearchResults(rows *sql.Rows, query string) ([]SearchResult, error) {
+	var results []SearchResult
+	for rows.Next() {
+		var post Post
+		var result SearchResult
+
+		err := rows.Scan(
+			&post.ID,
+			&post.Title,
+			&post.Content,
+			&post.Author,
+			&post.Slug,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+			&post.Status,
+			&post.PublishedAt,
+			&post.ScheduledFor,
+			&post.DeletedAt,
+			&post.AuthorID,
+			&result.Rank,
+			&result.Snippet,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Post = &post
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("invalid search query %q: %w", query, err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+)
+
+// PostStatus represents the publication state of a post
+type PostStatus string
+
+const (
+	StatusDraft     PostStatus = "draft"
+	StatusPublished PostStatus = "published"
+	StatusScheduled PostStatus = "scheduled"
+	StatusArchived  PostStatus = "archived"
+)
+
+// PostStatuses lists every valid post status
+var PostStatuses = []PostStatus{
+	StatusDraft,
+	StatusPublished,
+	StatusScheduled,
+	StatusArchived,
+}
+
+// ParsePostStatus validates and converts a string into a PostStatus
+func ParsePostStatus(s string) (PostStatus, error) {
+	for _, status := range PostStatuses {
+		if string(status) == s {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("invalid status %q (must be one of draft, published, scheduled, archived)", s)
+}
+
+// PublishPost marks a post as published as of now
+func (d *Database) PublishPost(ctx context.Context, id int) (*Post, error) {
+	now := time.Now().UTC()
+	return d.SetPostStatus(ctx, id, StatusPublished, TimeToNullTime(now), sql.NullTime{})
+}
+
+// UnpublishPost moves a post back to draft, clearing any publish or schedule dates
+func (d *Database) UnpublishPost(ctx context.Context, id int) (*Post, error) {
+	return d.SetPostStatus(ctx, id, StatusDraft, sql.NullTime{}, sql.NullTime{})
+}
+
+// SchedulePost schedules a post to be published at the given time
+func (d *Database) SchedulePost(ctx context.Context, id int, at time.Time) (*Post, error) {
+	if !at.After(time.Now()) {
+		return nil, errors.New("scheduled time must be in the future")
+	}
+	return d.SetPostStatus(ctx, id, StatusScheduled, sql.NullTime{}, TimeToNullTime(at.UTC()))
+}
+
+// ArchivePost archives a post, keeping its original publish date
+func (d *Database) ArchivePost(ctx context.Context, id int) (*Post, error) {
+	existing, err := d.GetPostByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return d.SetPostStatus(ctx, id, StatusArchived, existing.PublishedAt, sql.NullTime{})
+}
+
+// PublishDuePosts publishes every scheduled post whose scheduled time has passed
+// and returns the number of posts that were published
+func (d *Database) PublishDuePosts(ctx context.Context) (int64, error) {
+	now := time.Now().UTC()
+
+	params := repository.PublishDuePostsParams{
+		UpdatedAt:    sql.NullTime{Time: now, Valid: true},
+		ScheduledFor: sql.NullTime{Time: now, Valid: true},
+	}
+
+	return d.repo.PublishDuePosts(ctx, params)
+}
+
+// ListPostsByStatus retrieves posts with the given status, newest first, with
+// optional limit and offset
+func (d *Database) ListPostsByStatus(ctx context.Context, status PostStatus, limit, offset int) ([]*Post, error) {
+	if limit > 0 {
+		params := repository.ListPostsByStatusWithPaginationParams{
+			Status: string(status),
+			Limit:  int64(limit),
+			Offset: int64(offset),
+		}
+		posts, err := d.repo.ListPostsByStatusWithPagination(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		return toPostPointers(posts), nil
+	}
+
+	posts, err := d.repo.ListPostsByStatus(ctx, string(status))
+	if err != nil {
+		return nil, err
+	}
+	return toPostPointers(posts), nil
+}
+
+// SetPostStatus sets the status and publication timestamps of a post directly,
+// skipping the checks made by PublishPost, SchedulePost and friends. It's meant
+// for restoring a known state, such as when importing posts
+func (d *Database) SetPostStatus(ctx context.Context, id int, status PostStatus, publishedAt, scheduledFor sql.NullTime) (*Post, error) {
+	params := repository.UpdatePostStatusParams{
+		ID:           int64(id),
+		Status:       string(status),
+		PublishedAt:  publishedAt,
+		ScheduledFor: scheduledFor,
+		UpdatedAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	updatedPost, err := d.repo.UpdatePostStatus(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	return &updatedPost, nil
+}
+
+// setPostStatus moves a post to status with repo, along with the dates the
+// status calls for: publishing publishes it now, moving it to draft clears its
+// dates, archiving keeps its publish date and scheduling needs scheduledFor to
+// be in the future
+func setPostStatus(ctx context.Context, repo *repository.Queries, post Post, status PostStatus, scheduledFor sql.NullTime) (*Post, error) {
+	params := repository.UpdatePostStatusParams{
+		ID:        post.ID,
+		Status:    string(status),
+		UpdatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}
+
+	switch status {
+	case StatusPublished:
+		params.PublishedAt = TimeToNullTime(time.Now().UTC())
+	case StatusArchived:
+		params.PublishedAt = post.PublishedAt
+	case StatusScheduled:
+		if !scheduledFor.Valid || !scheduledFor.Time.After(time.Now()) {
+			return nil, errors.New("scheduled time must be in the future")
+		}
+		params.ScheduledFor = TimeToNullTime(scheduledFor.Time.UTC())
+	}
+
+	updated, err := repo.UpdatePostStatus(ctx, params)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	return &updated, nil
+}
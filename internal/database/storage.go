@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Storage is the set of operations the CMS performs on its posts, tags,
+// revisions, authors, redirects and media. Database implements it for SQLite
+// and PostgreSQL
+type Storage interface {
+	// Posts
+	CreatePost(ctx context.Context, post Post) (*Post, error)
+	CreatePostWithTags(ctx context.Context, post Post, tags []string) (*Post, []Tag, error)
+	GetPostByID(ctx context.Context, id int) (*Post, error)
+	GetPostBySlug(ctx context.Context, slug string) (*Post, bool, error)
+	UpdatePostByID(ctx context.Context, id int, updates Post) (*Post, error)
+	UpdatePostBySlug(ctx context.Context, slug string, updates Post) (*Post, error)
+	UpdatePostWithTags(ctx context.Context, id int, updates Post, tags []string) (*Post, []Tag, error)
+	DeletePostByID(ctx context.Context, id int) error
+	DeletePostBySlug(ctx context.Context, slug string) error
+	ListPosts(ctx context.Context, limit, offset int) ([]*Post, error)
+	ListPostsFiltered(ctx context.Context, filter PostFilter) ([]*Post, int64, error)
+	SearchPosts(ctx context.Context, query string, limit, offset int) ([]SearchResult, error)
+
+	// Revisions
+	ListPostRevisions(ctx context.Context, postID int) ([]PostRevision, error)
+	GetPostRevision(ctx context.Context, postID, revision int) (*PostRevision, error)
+	RestorePostRevision(ctx context.Context, postID, revision int) (*Post, error)
+
+	// Status
+	PublishPost(ctx context.Context, id int) (*Post, error)
+	UnpublishPost(ctx context.Context, id int) (*Post, error)
+	SchedulePost(ctx context.Context, id int, at time.Time) (*Post, error)
+	ArchivePost(ctx context.Context, id int) (*Post, error)
+	PublishDuePosts(ctx context.Context) (int64, error)
+	ListPostsByStatus(ctx context.Context, status PostStatus, limit, offset int) ([]*Post, error)
+	SetPostStatus(ctx context.Context, id int, status PostStatus, publishedAt, scheduledFor sql.NullTime) (*Post, error)
+
+	// Tags
+	SetPostTags(ctx context.Context, postID int, names []string) ([]Tag, error)
+	GetPostTags(ctx context.Context, postID int) ([]Tag, error)
+	ListTags(ctx context.Context) ([]TagWithCount, error)
+	GetTagByName(ctx context.Context, name string) (*Tag, error)
+	RenameTag(ctx context.Context, oldName, newName string) (*Tag, error)
+	MergeTags(ctx context.Context, sources []string, target string) (*Tag, error)
+	DeleteTag(ctx context.Context, name string) error
+	ListPostsByTag(ctx context.Context, tag string, limit, offset int) ([]*Post, error)
+
+	// Trash
+	ListTrashedPosts(ctx context.Context) ([]*Post, error)
+	RestorePostByID(ctx context.Context, id int) (*Post, error)
+	RestorePostBySlug(ctx context.Context, slug string) (*Post, error)
+	PurgePostByID(ctx context.Context, id int) error
+	PurgePostBySlug(ctx context.Context, slug string) error
+	PurgeTrash(ctx context.Context, before time.Time) (int64, error)
+
+	// Authors
+	ListAuthors(ctx context.Context) ([]AuthorWithCount, error)
+	GetAuthorByName(ctx context.Context, name string) (*Author, error)
+	CreateAuthor(ctx context.Context, author Author) (*Author, error)
+	UpdateAuthor(ctx context.Context, name string, updates Author) (*Author, error)
+	DeleteAuthor(ctx context.Context, name string, force bool) (int64, error)
+
+	// Redirects
+	ListRedirects(ctx context.Context) ([]Redirect, error)
+	AddRedirect(ctx context.Context, from, to string) (*Redirect, error)
+	RemoveRedirect(ctx context.Context, from string) error
+
+	// Media
+	AddMedia(ctx context.Context, media Media) (*Media, bool, error)
+	GetMedia(ctx context.Context, ref string) (*Media, error)
+	ListMedia(ctx context.Context) ([]Media, error)
+	DeleteMedia(ctx context.Context, hash string) error
+	ListPostContents(ctx context.Context) ([]string, error)
+
+	Close() error
+}
+
+var _ Storage = (*Database)(nil)
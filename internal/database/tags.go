@@ -0,0 +1,218 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/repository"
+)
+
+// Tag is an alias for the generated repository Tag type
+type Tag = repository.Tag
+
+// TagWithCount is a tag along with the number of posts using it
+type TagWithCount = repository.ListTagsRow
+
+// NormalizeTagName lowercases a tag name, trims it and joins words with hyphens
+func NormalizeTagName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), "-")
+}
+
+// SetPostTags replaces the tags on a post, creating any tags that don't exist yet
+func (d *Database) SetPostTags(ctx context.Context, postID int, names []string) ([]Tag, error) {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	tags, err := setPostTags(ctx, d.withTx(tx), postID, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// setPostTags replaces the tags on a post with repo
+func setPostTags(ctx context.Context, repo *repository.Queries, postID int, names []string) ([]Tag, error) {
+	if err := repo.DeletePostTags(ctx, int64(postID)); err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		name = NormalizeTagName(name)
+		if name == "" {
+			continue
+		}
+
+		tag, err := getOrCreateTag(ctx, repo, name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = repo.AddPostTag(ctx, repository.AddPostTagParams{
+			PostID: int64(postID),
+			TagID:  tag.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return repo.ListTagsForPost(ctx, int64(postID))
+}
+
+// GetPostTags retrieves the tags attached to a post
+func (d *Database) GetPostTags(ctx context.Context, postID int) ([]Tag, error) {
+	return d.repo.ListTagsForPost(ctx, int64(postID))
+}
+
+// ListTags retrieves all tags along with how many posts use each one, not
+// counting posts in the trash
+func (d *Database) ListTags(ctx context.Context) ([]TagWithCount, error) {
+	return d.repo.ListTags(ctx)
+}
+
+// GetTagByName retrieves a tag by its name
+func (d *Database) GetTagByName(ctx context.Context, name string) (*Tag, error) {
+	tag, err := d.repo.GetTagByName(ctx, NormalizeTagName(name))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTagNotFound
+		}
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// RenameTag renames a tag. Renaming onto an existing tag is rejected; use MergeTags instead
+func (d *Database) RenameTag(ctx context.Context, oldName, newName string) (*Tag, error) {
+	tag, err := d.GetTagByName(ctx, oldName)
+	if err != nil {
+		return nil, err
+	}
+
+	newName = NormalizeTagName(newName)
+	if newName == "" {
+		return nil, errors.New("new tag name cannot be empty")
+	}
+
+	if _, err := d.GetTagByName(ctx, newName); err == nil {
+		return nil, fmt.Errorf("tag %q already exists, merge the tags instead", newName)
+	}
+
+	renamedTag, err := d.repo.RenameTag(ctx, repository.RenameTagParams{
+		ID:   tag.ID,
+		Name: newName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &renamedTag, nil
+}
+
+// MergeTags moves every post from the source tags onto the target tag and then
+// deletes the source tags. The target tag is created if it doesn't exist
+func (d *Database) MergeTags(ctx context.Context, sources []string, target string) (*Tag, error) {
+	target = NormalizeTagName(target)
+	if target == "" {
+		return nil, errors.New("target tag name cannot be empty")
+	}
+
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	repo := d.withTx(tx)
+
+	targetTag, err := getOrCreateTag(ctx, repo, target)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, source := range sources {
+		sourceTag, err := repo.GetTagByName(ctx, NormalizeTagName(source))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("%w: %s", ErrTagNotFound, source)
+			}
+			return nil, err
+		}
+
+		if sourceTag.ID == targetTag.ID {
+			continue
+		}
+
+		postIDs, err := repo.ListPostIDsForTag(ctx, sourceTag.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, postID := range postIDs {
+			err = repo.AddPostTag(ctx, repository.AddPostTagParams{
+				PostID: postID,
+				TagID:  targetTag.ID,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := repo.DeleteTag(ctx, sourceTag.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &targetTag, nil
+}
+
+// DeleteTag deletes a tag and removes it from every post
+func (d *Database) DeleteTag(ctx context.Context, name string) error {
+	tag, err := d.GetTagByName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	return d.repo.DeleteTag(ctx, tag.ID)
+}
+
+// ListPostsByTag retrieves posts with the given tag, newest first, with
+// optional limit and offset. It's ListPostsFiltered with only a tag, so use
+// that to combine the tag with other filters such as a status
+func (d *Database) ListPostsByTag(ctx context.Context, tag string, limit, offset int) ([]*Post, error) {
+	posts, _, err := d.ListPostsFiltered(ctx, PostFilter{Tag: tag, Limit: limit, Offset: offset})
+	return posts, err
+}
+
+// getOrCreateTag looks up a tag by name, creating it when missing
+func getOrCreateTag(ctx context.Context, repo *repository.Queries, name string) (Tag, error) {
+	tag, err := repo.GetTagByName(ctx, name)
+	if err == nil {
+		return tag, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return Tag{}, err
+	}
+
+	return repo.CreateTag(ctx, repository.CreateTagParams{
+		Name:      name,
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
@@ -0,0 +1,78 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// ListTrashedPosts retrieves all posts in the trash, most recently deleted first
+func (d *Database) ListTrashedPosts(ctx context.Context) ([]*Post, error) {
+	posts, err := d.repo.ListTrashedPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPostPointers(posts), nil
+}
+
+// RestorePostByID moves a post out of the trash by its ID
+func (d *Database) RestorePostByID(ctx context.Context, id int) (*Post, error) {
+	post, err := d.repo.RestorePostByID(ctx, int64(id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	return &post, nil
+}
+
+// RestorePostBySlug moves a post out of the trash by its slug
+func (d *Database) RestorePostBySlug(ctx context.Context, slug string) (*Post, error) {
+	post, err := d.repo.RestorePostBySlug(ctx, sql.NullString{String: slug, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	return &post, nil
+}
+
+// PurgePostByID permanently deletes a trashed post by its ID
+func (d *Database) PurgePostByID(ctx context.Context, id int) error {
+	rows, err := d.repo.PurgePostByID(ctx, int64(id))
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrPostNotFound
+	}
+
+	return nil
+}
+
+// PurgePostBySlug permanently deletes a trashed post by its slug
+func (d *Database) PurgePostBySlug(ctx context.Context, slug string) error {
+	rows, err := d.repo.PurgePostBySlug(ctx, sql.NullString{String: slug, Valid: true})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return ErrPostNotFound
+	}
+
+	return nil
+}
+
+// PurgeTrash permanently deletes every post that was trashed before the given
+// time and returns the number of posts removed
+func (d *Database) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	return d.repo.PurgeTrashedPosts(ctx, sql.NullTime{Time: before.UTC(), Valid: true})
+}
@@ -1,6 +1,7 @@
 package editor
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -8,6 +9,9 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/frontmatter"
+	"gopkg.in/yaml.v3"
 )
 
 // Editor handles opening text editors for content editing
@@ -16,10 +20,18 @@ type Editor struct {
 	args    []string
 }
 
-// New creates a new Editor instance using the EDITOR environment variable
-// Falls back to common editors if EDITOR is not set
+// Preferred is the editor chosen in the cms configuration. When set it is used
+// instead of the EDITOR environment variable
+var Preferred string
+
+// New creates a new Editor instance using the preferred editor or else the
+// EDITOR environment variable
+// Falls back to common editors if neither is set
 func New() *Editor {
-	editorCmd := os.Getenv("EDITOR")
+	editorCmd := Preferred
+	if editorCmd == "" {
+		editorCmd = os.Getenv("EDITOR")
+	}
 	
 	if editorCmd == "" {
 		// Fallback to common editors based on OS
@@ -45,7 +57,10 @@ func New() *Editor {
 	}
 }
 
-// EditContent opens an editor with initial content and returns the edited content
+// EditContent opens an editor with initial content and returns the edited
+// content. Content that isn't changed in the editor is returned byte-for-byte.
+// Most editors end a saved file with a newline, so one is added to the file
+// and removed again after editing
 func (e *Editor) EditContent(initialContent string) (string, error) {
 	// Create a temporary file
 	tmpFile, err := os.CreateTemp("", "cms-edit-*.md")
@@ -55,11 +70,9 @@ func (e *Editor) EditContent(initialContent string) (string, error) {
 	defer os.Remove(tmpFile.Name()) // Clean up temp file
 
 	// Write initial content to temp file
-	if initialContent != "" {
-		if _, err := tmpFile.WriteString(initialContent); err != nil {
-			tmpFile.Close()
-			return "", fmt.Errorf("failed to write initial content: %w", err)
-		}
+	if _, err := tmpFile.WriteString(initialContent + "\n"); err != nil {
+		tmpFile.Close()
+		return "", fmt.Errorf("failed to write initial content: %w", err)
 	}
 
 	// Close the file so the editor can open it
@@ -87,67 +100,88 @@ func (e *Editor) EditContent(initialContent string) (string, error) {
 		return "", fmt.Errorf("failed to read edited content: %w", err)
 	}
 
-	return strings.TrimSpace(string(content)), nil
+	return strings.TrimSuffix(string(content), "\n"), nil
 }
 
-// EditContentWithTemplate opens an editor with a template and returns the edited content
-func (e *Editor) EditContentWithTemplate(title, author, existingContent string, isUpdate bool) (string, error) {
-	var template strings.Builder
-	
-	// Add template content
-	if isUpdate {
-		template.WriteString("# Editing Post\n")
-	} else {
-		template.WriteString("# Creating New Post\n")
+// Post holds the post fields shown as editable YAML frontmatter at the top of
+// the editor template. Content is the text below the frontmatter
+type Post struct {
+	Title   string   `yaml:"title"`
+	Author  string   `yaml:"author"`
+	Slug    string   `yaml:"slug"`
+	Tags    []string `yaml:"tags"`
+	Status  string   `yaml:"status"`
+	Content string   `yaml:"-"`
+}
+
+// EditContentWithTemplate opens an editor with the post's fields as YAML
+// frontmatter followed by its content, and returns the edited post
+func (e *Editor) EditContentWithTemplate(post Post, isUpdate bool) (*Post, error) {
+	template, err := renderTemplate(post, isUpdate)
+	if err != nil {
+		return nil, err
 	}
-	template.WriteString("#\n")
-	
-	if title != "" {
-		template.WriteString(fmt.Sprintf("# Title: %s\n", title))
+
+	// Edit the template
+	editedContent, err := e.EditContent(template)
+	if err != nil {
+		return nil, err
 	}
-	if author != "" {
-		template.WriteString(fmt.Sprintf("# Author: %s\n", author))
+
+	return parseTemplate(editedContent)
+}
+
+// renderTemplate writes a post as a frontmatter block with instructions in
+// YAML comments, followed by its content
+func renderTemplate(post Post, isUpdate bool) (string, error) {
+	heading := "Creating New Post"
+	if isUpdate {
+		heading = "Editing Post"
 	}
-	
-	template.WriteString("#\n")
-	template.WriteString("# Write your post content below this line.\n")
-	template.WriteString("# Lines starting with '#' are comments and will be ignored.\n")
-	template.WriteString("#\n")
-	template.WriteString("\n")
-	
-	// Add existing content if provided
-	if existingContent != "" {
-		template.WriteString(existingContent)
+
+	var node yaml.Node
+	if err := node.Encode(post); err != nil {
+		return "", fmt.Errorf("failed to render template: %w", err)
 	}
+	node.HeadComment = heading + `
 
-	// Edit the template
-	editedContent, err := e.EditContent(template.String())
+Edit the fields below and write your post content after the closing ---.
+status is one of draft, published or archived.`
+
+	data, err := frontmatter.Marshal(&node, post.Content)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("failed to render template: %w", err)
 	}
 
-	// Filter out comment lines and return clean content
-	return e.filterComments(editedContent), nil
+	return string(data), nil
 }
 
-// filterComments removes lines starting with '#' and trims whitespace
-func (e *Editor) filterComments(content string) string {
-	lines := strings.Split(content, "\n")
-	var filtered []string
-	
-	for _, line := range lines {
-		trimmed := strings.TrimSpace(line)
-		// Skip empty lines and comment lines
-		if trimmed != "" && !strings.HasPrefix(trimmed, "#") {
-			filtered = append(filtered, line)
-		}
+// parseTemplate reads back an edited template
+func parseTemplate(content string) (*Post, error) {
+	var post Post
+	body, err := frontmatter.Unmarshal([]byte(content), &post)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse edited post: %w", err)
 	}
-	
-	return strings.TrimSpace(strings.Join(filtered, "\n"))
+
+	post.Title = strings.TrimSpace(post.Title)
+	if post.Title == "" {
+		return nil, errors.New("failed to parse edited post: title cannot be empty")
+	}
+
+	post.Author = strings.TrimSpace(post.Author)
+	post.Slug = strings.TrimSpace(post.Slug)
+	post.Status = strings.TrimSpace(post.Status)
+	post.Content = body
+
+	return &post, nil
 }
 
 // GetEditorInfo returns information about the configured editor
 func (e *Editor) GetEditorInfo() string {
+	if Preferred != "" {
+		return fmt.Sprintf("%s (from cms config)", Preferred)
+	}
 	editorEnv := os.Getenv("EDITOR")
 	if editorEnv != "" {
 		return fmt.Sprintf("%s (from EDITOR env var)", editorEnv)
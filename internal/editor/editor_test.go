@@ -71,6 +71,18 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewPreferred(t *testing.T) {
+	t.Setenv("EDITOR", "vim")
+	Preferred = "code --wait"
+	defer func() { Preferred = "" }()
+
+	editor := New()
+
+	assert.Equal(t, "code", editor.command, "the configured editor wins over EDITOR")
+	assert.Equal(t, []string{"--wait"}, editor.args)
+	assert.Equal(t, "code --wait (from cms config)", editor.GetEditorInfo())
+}
+
 func TestEditor_GetEditorInfo(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -137,60 +149,6 @@ func TestEditor_IsAvailable(t *testing.T) {
 	}
 }
 
-func TestEditor_filterComments(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected string
-	}{
-		{
-			name: "Mixed content with comments",
-			input: `# This is a comment
-This is content
-# Another comment
-More content
-   # Indented comment  
-Final content`,
-			expected: "This is content\nMore content\nFinal content",
-		},
-		{
-			name:     "Only comments",
-			input:    "# Comment 1\n# Comment 2\n# Comment 3",
-			expected: "",
-		},
-		{
-			name:     "No comments",
-			input:    "Line 1\nLine 2\nLine 3",
-			expected: "Line 1\nLine 2\nLine 3",
-		},
-		{
-			name:     "Empty input",
-			input:    "",
-			expected: "",
-		},
-		{
-			name:     "Only whitespace and comments",
-			input:    "  \n  # Comment  \n\t\n",
-			expected: "",
-		},
-		{
-			name: "Hash in middle of line",
-			input: `This line has # hash in middle
-# This is a comment
-Normal content`,
-			expected: "This line has # hash in middle\nNormal content",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			editor := New()
-			result := editor.filterComments(tt.input)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestWriteToFile(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -306,106 +264,207 @@ func TestEditor_CreateContentFile(t *testing.T) {
 	}
 }
 
+// scriptEditor returns an Editor that runs a shell script on the file being
+// edited, which is available to the script as $1
+func scriptEditor(t *testing.T, script string) *Editor {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("Editor script needs a POSIX shell")
+	}
+
+	// Keep the temp file and any backups out of the shared temp directory
+	t.Setenv("TMPDIR", t.TempDir())
+
+	return &Editor{command: "sh", args: []string{"-c", script, "sh"}}
+}
+
+// roundTripContent is post content that used to be mangled by the editor
+var roundTripContent = []struct {
+	name    string
+	content string
+}{
+	{name: "Empty", content: ""},
+	{name: "Plain text", content: "Just one line"},
+	{name: "Headings", content: "# Title\n\nIntro\n\n## Section\n\n### Subsection\nText"},
+	{name: "Paragraph breaks", content: "First paragraph.\n\nSecond paragraph.\n\n\n\nThird after several blank lines."},
+	{name: "Leading and trailing whitespace", content: "\n\n  indented first line\n\t\n"},
+	{name: "Trailing newline", content: "Ends with a newline\n"},
+	{name: "Code block with comments", content: "```sh\n# install\nmake install\n```\n"},
+	{name: "Frontmatter delimiter in body", content: "Above\n\n---\n\nBelow"},
+	{name: "CRLF line endings", content: "Line one\r\n\r\n## Line two\r\n"},
+}
+
+func TestEditor_EditContent_RoundTrip(t *testing.T) {
+	editors := map[string]string{
+		// Saves the file without touching it
+		"unchanged": ":",
+		// Adds a final newline when the file doesn't end in one, like vim and nano
+		"fixes final newline": `[ -z "$(tail -c 1 "$1")" ] || echo >> "$1"`,
+	}
+
+	for editorName, script := range editors {
+		editor := scriptEditor(t, script)
+
+		for _, tt := range roundTripContent {
+			t.Run(editorName+"/"+tt.name, func(t *testing.T) {
+				edited, err := editor.EditContent(tt.content)
+				require.NoError(t, err)
+				assert.Equal(t, tt.content, edited)
+			})
+		}
+	}
+}
+
+func TestEditor_EditContentWithTemplate_RoundTrip(t *testing.T) {
+	editor := scriptEditor(t, ":")
+
+	for _, tt := range roundTripContent {
+		t.Run(tt.name, func(t *testing.T) {
+			edited, err := editor.EditContentWithTemplate(Post{Title: "Title", Content: tt.content}, true)
+			require.NoError(t, err)
+			assert.Equal(t, tt.content, edited.Content)
+		})
+	}
+}
+
+func TestEditor_EditContent_KeepsAddedHeadings(t *testing.T) {
+	editor := scriptEditor(t, `printf '\n## Added section\n\nMore text\n' >> "$1"`)
+
+	edited, err := editor.EditContent("# Heading\n\nText")
+	require.NoError(t, err)
+	assert.Equal(t, "# Heading\n\nText\n\n## Added section\n\nMore text", edited)
+}
+
 func TestEditor_EditContentWithTemplate(t *testing.T) {
-	// This test focuses on the template generation part
-	// We can't test the interactive editing without mocking
-	
-	editor := New()
-	
+	// The "editor" is a shell script that edits the frontmatter in place
+	editor := scriptEditor(t, `sed -i.bak -e 's/^title: .*/title: New Title/' -e 's/^author: .*/author: New Author/' -e 's/^tags: .*/tags: [go, cli]/' -e 's/^status: .*/status: published/' "$1"`)
+
+	edited, err := editor.EditContentWithTemplate(Post{
+		Title:   "Old Title",
+		Author:  "Old Author",
+		Slug:    "old-title",
+		Status:  "draft",
+		Content: "Existing content here",
+	}, true)
+	require.NoError(t, err)
+
+	assert.Equal(t, &Post{
+		Title:   "New Title",
+		Author:  "New Author",
+		Slug:    "old-title",
+		Tags:    []string{"go", "cli"},
+		Status:  "published",
+		Content: "Existing content here",
+	}, edited)
+}
+
+func TestRenderTemplate(t *testing.T) {
 	tests := []struct {
-		name            string
-		title           string
-		author          string
-		existingContent string
-		isUpdate        bool
-		containsTitle   bool
-		containsAuthor  bool
+		name     string
+		post     Post
+		isUpdate bool
+		contains []string
 	}{
 		{
-			name:          "New post with all fields",
-			title:         "Test Title",
-			author:        "Test Author",
-			isUpdate:      false,
-			containsTitle: true,
-			containsAuthor: true,
+			name:     "New post with all fields",
+			post:     Post{Title: "Test Title", Author: "Test Author", Slug: "test-title", Status: "draft"},
+			isUpdate: false,
+			contains: []string{"# Creating New Post", "title: Test Title\n", "author: Test Author\n", "slug: test-title\n", "tags: []\n", "status: draft\n"},
 		},
 		{
-			name:            "Update existing post",
-			title:           "Updated Title",
-			author:          "Updated Author",
-			existingContent: "Existing content here",
-			isUpdate:        true,
-			containsTitle:   true,
-			containsAuthor:  true,
+			name: "Update existing post",
+			post: Post{
+				Title:   "Updated Title",
+				Tags:    []string{"go"},
+				Status:  "published",
+				Content: "Existing content here",
+			},
+			isUpdate: true,
+			contains: []string{"# Editing Post", "title: Updated Title\n", "tags:\n  - go\n", "---\n\nExisting content here"},
 		},
 		{
-			name:     "Minimal new post",
-			isUpdate: false,
+			name:     "Title that needs quoting",
+			post:     Post{Title: "Go: a tour"},
+			contains: []string{"title: 'Go: a tour'\n"},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// We'll test the template building logic by examining what would be passed to EditContent
-			var template strings.Builder
-			
-			if tt.isUpdate {
-				template.WriteString("# Editing Post\n")
-			} else {
-				template.WriteString("# Creating New Post\n")
-			}
-			template.WriteString("#\n")
-			
-			if tt.title != "" {
-				template.WriteString("# Title: " + tt.title + "\n")
-			}
-			if tt.author != "" {
-				template.WriteString("# Author: " + tt.author + "\n")
-			}
-			
-			template.WriteString("#\n")
-			template.WriteString("# Write your post content below this line.\n")
-			template.WriteString("# Lines starting with '#' are comments and will be ignored.\n")
-			template.WriteString("#\n")
-			template.WriteString("\n")
-			
-			if tt.existingContent != "" {
-				template.WriteString(tt.existingContent)
-			}
+			template, err := renderTemplate(tt.post, tt.isUpdate)
+			require.NoError(t, err)
 
-			templateStr := template.String()
-			
-			// Verify template contains expected elements
-			if tt.isUpdate {
-				assert.Contains(t, templateStr, "# Editing Post")
-			} else {
-				assert.Contains(t, templateStr, "# Creating New Post")
-			}
-			
-			if tt.containsTitle {
-				assert.Contains(t, templateStr, "# Title: "+tt.title)
-			}
-			
-			if tt.containsAuthor {
-				assert.Contains(t, templateStr, "# Author: "+tt.author)
-			}
-			
-			if tt.existingContent != "" {
-				assert.Contains(t, templateStr, tt.existingContent)
+			assert.True(t, strings.HasPrefix(template, "---\n"))
+			for _, want := range tt.contains {
+				assert.Contains(t, template, want)
 			}
-			
-			// Test comment filtering
-			filtered := editor.filterComments(templateStr)
-			
-			// Filtered content should not contain comment lines
-			lines := strings.Split(filtered, "\n")
-			for _, line := range lines {
-				trimmed := strings.TrimSpace(line)
-				if trimmed != "" {
-					assert.False(t, strings.HasPrefix(trimmed, "#"), 
-						"Filtered content should not contain comment lines: %s", line)
-				}
+
+			// An unedited template parses back into the same post
+			parsed, err := parseTemplate(template)
+			require.NoError(t, err)
+			assert.Equal(t, tt.post.Title, parsed.Title)
+			assert.Equal(t, tt.post.Author, parsed.Author)
+			assert.Equal(t, tt.post.Slug, parsed.Slug)
+			assert.Equal(t, tt.post.Status, parsed.Status)
+			assert.Equal(t, tt.post.Content, parsed.Content)
+			assert.ElementsMatch(t, tt.post.Tags, parsed.Tags)
+		})
+	}
+}
+
+func TestParseTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expected    *Post
+		errContains string
+	}{
+		{
+			name:  "All fields",
+			input: "---\ntitle: '  Spaced  '\nauthor: Jane\nslug: spaced\ntags: [a, b]\nstatus: archived\n---\n\nBody text",
+			expected: &Post{
+				Title:   "Spaced",
+				Author:  "Jane",
+				Slug:    "spaced",
+				Tags:    []string{"a", "b"},
+				Status:  "archived",
+				Content: "Body text",
+			},
+		},
+		{
+			name:     "Missing optional fields",
+			input:    "---\ntitle: Only a title\n---\n",
+			expected: &Post{Title: "Only a title"},
+		},
+		{
+			name:        "Empty title",
+			input:       "---\ntitle: ''\n---\n\nBody",
+			errContains: "title cannot be empty",
+		},
+		{
+			name:        "Frontmatter removed",
+			input:       "Just some content",
+			errContains: "missing frontmatter",
+		},
+		{
+			name:        "Unknown field",
+			input:       "---\ntitle: Post\ncategory: news\n---\n",
+			errContains: "category",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			post, err := parseTemplate(tt.input)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
 			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, post)
 		})
 	}
 }
@@ -433,21 +492,6 @@ func getCommonCommand() string {
 }
 
 // Benchmark tests
-func BenchmarkEditor_filterComments(b *testing.B) {
-	editor := New()
-	content := `# Comment 1
-Real content line 1
-# Comment 2
-Real content line 2
-# Comment 3
-Real content line 3`
-
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_ = editor.filterComments(content)
-	}
-}
-
 func BenchmarkWriteToFile(b *testing.B) {
 	tempDir := b.TempDir()
 	content := "This is test content for benchmarking file writes."
@@ -0,0 +1,325 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/site"
+)
+
+// Format is a feed format
+type Format string
+
+const (
+	FormatRSS  Format = "rss"
+	FormatAtom Format = "atom"
+	FormatJSON Format = "json"
+)
+
+// Formats lists every supported feed format
+var Formats = []Format{FormatRSS, FormatAtom, FormatJSON}
+
+// ParseFormat validates and converts a string into a Format
+func ParseFormat(s string) (Format, error) {
+	for _, format := range Formats {
+		if string(format) == s {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("invalid feed format %q (must be one of rss, atom, json)", s)
+}
+
+// Filename is the conventional file name for a feed in a site directory
+func (f Format) Filename() string {
+	switch f {
+	case FormatAtom:
+		return "atom.xml"
+	case FormatJSON:
+		return "feed.json"
+	}
+	return "rss.xml"
+}
+
+// Config describes the feed itself
+type Config struct {
+	Title       string
+	Description string
+	// BaseURL is the absolute URL of the site, e.g. https://blog.example.com/
+	BaseURL string
+}
+
+// Write writes posts as a feed in the given format. Posts should already be
+// ordered newest first
+func Write(w io.Writer, format Format, cfg Config, posts []site.Post) error {
+	switch format {
+	case FormatRSS:
+		return writeRSS(w, cfg, posts)
+	case FormatAtom:
+		return writeAtom(w, cfg, posts)
+	case FormatJSON:
+		return writeJSON(w, cfg, posts)
+	}
+	return fmt.Errorf("invalid feed format %q", format)
+}
+
+// feedURL is the absolute URL the feed itself is published at
+func feedURL(cfg Config, format Format) string {
+	return site.JoinURL(cfg.BaseURL, format.Filename())
+}
+
+// lastUpdated returns the most recent update time across posts
+func lastUpdated(posts []site.Post) time.Time {
+	var latest time.Time
+	for _, post := range posts {
+		latest = maxTime(latest, maxTime(post.PublishedAt, post.UpdatedAt))
+	}
+	if latest.IsZero() {
+		latest = time.Now()
+	}
+	return latest.UTC()
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// updatedAt is when a post last changed, never earlier than its publish date
+func updatedAt(post site.Post) time.Time {
+	return maxTime(post.PublishedAt, post.UpdatedAt).UTC()
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	AtomNS  string     `xml:"xmlns:atom,attr"`
+	DCNS    string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	AtomLink      rssLink   `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Generator     string    `xml:"generator"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Creator     string   `xml:"dc:creator,omitempty"`
+	Categories  []string `xml:"category"`
+	Description string   `xml:"description"`
+}
+
+type rssGUID struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+}
+
+func writeRSS(w io.Writer, cfg Config, posts []site.Post) error {
+	feed := rssFeed{
+		Version: "2.0",
+		AtomNS:  "http://www.w3.org/2005/Atom",
+		DCNS:    "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:       cfg.Title,
+			Link:        site.JoinURL(cfg.BaseURL, ""),
+			Description: cfg.Description,
+			AtomLink: rssLink{
+				Href: feedURL(cfg, FormatRSS),
+				Rel:  "self",
+				Type: "application/rss+xml",
+			},
+			LastBuildDate: lastUpdated(posts).Format(time.RFC1123Z),
+			Generator:     "cms",
+		},
+	}
+
+	for _, post := range posts {
+		link := site.JoinURL(cfg.BaseURL, post.URL)
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       post.Title,
+			Link:        link,
+			GUID:        rssGUID{Value: link, IsPermaLink: true},
+			PubDate:     post.PublishedAt.UTC().Format(time.RFC1123Z),
+			Creator:     post.Author,
+			Categories:  post.Tags,
+			Description: string(post.Content),
+		})
+	}
+
+	return writeXML(w, feed)
+}
+
+type atomFeed struct {
+	XMLName   xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title     string      `xml:"title"`
+	Subtitle  string      `xml:"subtitle,omitempty"`
+	ID        string      `xml:"id"`
+	Updated   string      `xml:"updated"`
+	Links     []atomLink  `xml:"link"`
+	Generator string      `xml:"generator"`
+	Entries   []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     *atomAuthor    `xml:"author,omitempty"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func writeAtom(w io.Writer, cfg Config, posts []site.Post) error {
+	home := site.JoinURL(cfg.BaseURL, "")
+
+	feed := atomFeed{
+		Title:    cfg.Title,
+		Subtitle: cfg.Description,
+		ID:       home,
+		Updated:  lastUpdated(posts).Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: home},
+			{Href: feedURL(cfg, FormatAtom), Rel: "self", Type: "application/atom+xml"},
+		},
+		Generator: "cms",
+	}
+
+	for _, post := range posts {
+		link := site.JoinURL(cfg.BaseURL, post.URL)
+		entry := atomEntry{
+			Title:     post.Title,
+			ID:        link,
+			Link:      atomLink{Href: link},
+			Published: post.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:   updatedAt(post).Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Value: string(post.Content)},
+		}
+
+		// Atom requires an author on every entry unless the feed has one
+		name := post.Author
+		if name == "" {
+			name = cfg.Title
+		}
+		entry.Author = &atomAuthor{Name: name}
+
+		for _, tag := range post.Tags {
+			entry.Categories = append(entry.Categories, atomCategory{Term: tag})
+		}
+
+		feed.Entries = append(feed.Entries, entry)
+	}
+
+	return writeXML(w, feed)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+type jsonFeed struct {
+	Version     string     `json:"version"`
+	Title       string     `json:"title"`
+	HomePageURL string     `json:"home_page_url"`
+	FeedURL     string     `json:"feed_url"`
+	Description string     `json:"description,omitempty"`
+	Items       []jsonItem `json:"items"`
+}
+
+type jsonItem struct {
+	ID            string       `json:"id"`
+	URL           string       `json:"url"`
+	Title         string       `json:"title"`
+	ContentHTML   string       `json:"content_html"`
+	DatePublished string       `json:"date_published"`
+	DateModified  string       `json:"date_modified"`
+	Authors       []jsonAuthor `json:"authors,omitempty"`
+	Tags          []string     `json:"tags,omitempty"`
+}
+
+type jsonAuthor struct {
+	Name string `json:"name"`
+}
+
+func writeJSON(w io.Writer, cfg Config, posts []site.Post) error {
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       cfg.Title,
+		HomePageURL: site.JoinURL(cfg.BaseURL, ""),
+		FeedURL:     feedURL(cfg, FormatJSON),
+		Description: cfg.Description,
+		Items:       []jsonItem{},
+	}
+
+	for _, post := range posts {
+		link := site.JoinURL(cfg.BaseURL, post.URL)
+		item := jsonItem{
+			ID:            link,
+			URL:           link,
+			Title:         post.Title,
+			ContentHTML:   string(post.Content),
+			DatePublished: post.PublishedAt.UTC().Format(time.RFC3339),
+			DateModified:  updatedAt(post).Format(time.RFC3339),
+			Tags:          post.Tags,
+		}
+		if post.Author != "" {
+			item.Authors = []jsonAuthor{{Name: post.Author}}
+		}
+
+		feed.Items = append(feed.Items, item)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(feed)
+}
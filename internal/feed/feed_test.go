@@ -0,0 +1,107 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/site"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testPosts() []site.Post {
+	published := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	return []site.Post{
+		{
+			Title:       "Newest <post>",
+			Slug:        "newest",
+			Author:      "Jane",
+			Tags:        []string{"go"},
+			URL:         site.PostURL("newest"),
+			Content:     "<p>Hello &amp; welcome</p>",
+			PublishedAt: published,
+			UpdatedAt:   published.Add(time.Hour),
+		},
+		{
+			Title:       "Older",
+			Slug:        "older",
+			URL:         site.PostURL("older"),
+			Content:     "<p>Old</p>",
+			PublishedAt: published.Add(-24 * time.Hour),
+			UpdatedAt:   published.Add(-24 * time.Hour),
+		},
+	}
+}
+
+var testConfig = Config{
+	Title:       "Test Blog",
+	Description: "Posts about testing",
+	BaseURL:     "https://blog.example.com",
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("atom")
+	require.NoError(t, err)
+	assert.Equal(t, FormatAtom, format)
+	assert.Equal(t, "atom.xml", format.Filename())
+
+	_, err = ParseFormat("html")
+	assert.Error(t, err)
+}
+
+func TestWriteRSS(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatRSS, testConfig, testPosts()))
+
+	var feed rssFeed
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &feed), buf.String())
+	assert.Equal(t, "Test Blog", feed.Channel.Title)
+	assert.Contains(t, buf.String(), "<link>https://blog.example.com/</link>")
+	require.Len(t, feed.Channel.Items, 2)
+
+	item := feed.Channel.Items[0]
+	assert.Equal(t, "Newest <post>", item.Title)
+	assert.Equal(t, "https://blog.example.com/posts/newest/", item.Link)
+	assert.Equal(t, "Sat, 01 Mar 2025 09:00:00 +0000", item.PubDate)
+	assert.Equal(t, "<p>Hello &amp; welcome</p>", item.Description)
+	assert.Equal(t, []string{"go"}, item.Categories)
+	assert.Contains(t, buf.String(), `<dc:creator>Jane</dc:creator>`)
+	assert.Contains(t, buf.String(), `<atom:link href="https://blog.example.com/rss.xml" rel="self"`)
+}
+
+func TestWriteAtom(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatAtom, testConfig, testPosts()))
+
+	var feed atomFeed
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &feed), buf.String())
+	assert.Equal(t, "2025-03-01T10:00:00Z", feed.Updated)
+	require.Len(t, feed.Entries, 2)
+
+	assert.Equal(t, "2025-03-01T10:00:00Z", feed.Entries[0].Updated)
+	assert.Equal(t, "Jane", feed.Entries[0].Author.Name)
+	assert.Equal(t, "Test Blog", feed.Entries[1].Author.Name, "Entries without an author fall back to the feed title")
+	assert.Equal(t, "html", feed.Entries[0].Content.Type)
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, FormatJSON, testConfig, testPosts()))
+
+	var feed jsonFeed
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &feed))
+	assert.Equal(t, "https://jsonfeed.org/version/1.1", feed.Version)
+	assert.Equal(t, "https://blog.example.com/feed.json", feed.FeedURL)
+	require.Len(t, feed.Items, 2)
+	assert.Equal(t, "2025-03-01T09:00:00Z", feed.Items[0].DatePublished)
+	assert.Equal(t, []jsonAuthor{{Name: "Jane"}}, feed.Items[0].Authors)
+	assert.Empty(t, feed.Items[1].Authors)
+
+	// An empty feed still has an items array
+	buf.Reset()
+	require.NoError(t, Write(&buf, FormatJSON, testConfig, nil))
+	assert.Contains(t, buf.String(), `"items": []`)
+}
@@ -0,0 +1,55 @@
+package forms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+// ConfirmCreateAuthor asks whether to add an author who doesn't exist yet,
+// pointing out any existing authors with a similar name. It returns
+// ErrUserCancelled if the user declines
+func ConfirmCreateAuthor(name string, existing []string) error {
+	description := "They will be added to the authors list"
+	if similar := SimilarNames(name, existing); len(similar) > 0 {
+		description = "Similar authors: " + strings.Join(similar, ", ")
+	}
+
+	var confirm bool
+	confirmInput := huh.NewConfirm().
+		Title(fmt.Sprintf("Author %q doesn't exist. Create them?", name)).
+		Description(description).
+		Affirmative("Create").
+		Negative("Cancel").
+		Value(&confirm)
+
+	if err := huh.NewForm(huh.NewGroup(confirmInput)).Run(); err != nil {
+		return err
+	}
+
+	if !confirm {
+		return ErrUserCancelled
+	}
+	return nil
+}
+
+// SimilarNames returns the names that share a word with name, ignoring case,
+// so "john" suggests "John Smith"
+func SimilarNames(name string, names []string) []string {
+	words := map[string]bool{}
+	for _, word := range strings.Fields(strings.ToLower(name)) {
+		words[word] = true
+	}
+
+	var similar []string
+	for _, candidate := range names {
+		for _, word := range strings.Fields(strings.ToLower(candidate)) {
+			if words[word] {
+				similar = append(similar, candidate)
+				break
+			}
+		}
+	}
+	return similar
+}
@@ -0,0 +1,16 @@
+package forms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarNames(t *testing.T) {
+	names := []string{"John Smith", "Jane Doe", "Johnny", "CMS Admin"}
+
+	assert.Equal(t, []string{"John Smith"}, SimilarNames("john", names))
+	assert.Equal(t, []string{"John Smith", "Jane Doe"}, SimilarNames("Jane Smith", names))
+	assert.Empty(t, SimilarNames("Gopher", names))
+	assert.Empty(t, SimilarNames("", names))
+}
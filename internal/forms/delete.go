@@ -0,0 +1,110 @@
+package forms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+)
+
+// ConfirmDelete asks the user to confirm moving a post to the trash, showing
+// its details. It returns ErrUserCancelled if they decline
+func ConfirmDelete(post *database.Post) error {
+	var confirm bool
+
+	confirmInput := huh.NewConfirm().
+		Title(fmt.Sprintf("Move %q to the trash?", post.Title)).
+		Description(PostDetails(post)).
+		Affirmative("Delete").
+		Negative("Cancel").
+		Value(&confirm)
+
+	if err := huh.NewForm(huh.NewGroup(confirmInput)).Run(); err != nil {
+		return err
+	}
+
+	if !confirm {
+		return ErrUserCancelled
+	}
+	return nil
+}
+
+// SelectPostsToDelete shows a multi-select list of posts and returns the ones
+// picked. With confirm set, the user is asked again before anything is
+// returned. It returns ErrUserCancelled if nothing is picked or they decline
+func SelectPostsToDelete(posts []*database.Post, confirm bool) ([]*database.Post, error) {
+	options := make([]huh.Option[int], len(posts))
+	for i, post := range posts {
+		options[i] = huh.NewOption(postLabel(post), i)
+	}
+
+	var picked []int
+	selectInput := huh.NewMultiSelect[int]().
+		Title("Posts to delete").
+		Description("space to select, / to filter, enter when done").
+		Options(options...).
+		Filterable(true).
+		Value(&picked)
+
+	if err := huh.NewForm(huh.NewGroup(selectInput)).Run(); err != nil {
+		return nil, err
+	}
+
+	if len(picked) == 0 {
+		return nil, ErrUserCancelled
+	}
+
+	selected := make([]*database.Post, len(picked))
+	titles := make([]string, len(picked))
+	for i, index := range picked {
+		selected[i] = posts[index]
+		titles[i] = "• " + postLabel(posts[index])
+	}
+
+	if !confirm {
+		return selected, nil
+	}
+
+	var confirmed bool
+	confirmInput := huh.NewConfirm().
+		Title(fmt.Sprintf("Move %d post(s) to the trash?", len(selected))).
+		Description(strings.Join(titles, "\n")).
+		Affirmative("Delete").
+		Negative("Cancel").
+		Value(&confirmed)
+
+	if err := huh.NewForm(huh.NewGroup(confirmInput)).Run(); err != nil {
+		return nil, err
+	}
+
+	if !confirmed {
+		return nil, ErrUserCancelled
+	}
+	return selected, nil
+}
+
+// PostDetails summarises a post for a confirmation prompt
+func PostDetails(post *database.Post) string {
+	lines := []string{fmt.Sprintf("ID: %d", post.ID)}
+	if post.Slug.Valid {
+		lines = append(lines, "Slug: "+post.Slug.String)
+	}
+	if post.Author.Valid && post.Author.String != "" {
+		lines = append(lines, "Author: "+post.Author.String)
+	}
+	lines = append(lines, "Status: "+post.Status)
+	if post.CreatedAt.Valid {
+		lines = append(lines, "Created: "+post.CreatedAt.Time.Local().Format("2006-01-02 15:04"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// postLabel is how a post is shown in a list of options
+func postLabel(post *database.Post) string {
+	label := fmt.Sprintf("#%d %s", post.ID, post.Title)
+	if post.Slug.Valid {
+		label += " (" + post.Slug.String + ")"
+	}
+	return label + " · " + post.Status
+}
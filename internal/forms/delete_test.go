@@ -0,0 +1,36 @@
+package forms
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostDetails(t *testing.T) {
+	post := &database.Post{
+		ID:        7,
+		Title:     "Hello",
+		Slug:      database.StringToNullString("hello"),
+		Author:    database.StringToNullString("Jane"),
+		Status:    "draft",
+		CreatedAt: sql.NullTime{Time: time.Date(2025, 3, 1, 9, 30, 0, 0, time.Local), Valid: true},
+	}
+
+	assert.Equal(t, "ID: 7\nSlug: hello\nAuthor: Jane\nStatus: draft\nCreated: 2025-03-01 09:30", PostDetails(post))
+
+	post.Slug = sql.NullString{}
+	post.Author = sql.NullString{}
+	post.CreatedAt = sql.NullTime{}
+	assert.Equal(t, "ID: 7\nStatus: draft", PostDetails(post))
+}
+
+func TestPostLabel(t *testing.T) {
+	post := &database.Post{ID: 3, Title: "Hello", Status: "published"}
+	assert.Equal(t, "#3 Hello · published", postLabel(post))
+
+	post.Slug = database.StringToNullString("hello")
+	assert.Equal(t, "#3 Hello (hello) · published", postLabel(post))
+}
@@ -6,6 +6,9 @@ var (
 	// ErrUserCancelled is returned when the user cancels the form
 	ErrUserCancelled = errors.New("user cancelled the operation")
 	
+	// ErrNoChanges is returned when a form was submitted without changing anything
+	ErrNoChanges = errors.New("nothing was changed")
+
 	// ErrFormValidation is returned when form validation fails
 	ErrFormValidation = errors.New("form validation failed")
 )
\ No newline at end of file
@@ -15,6 +15,8 @@ type PostFormData struct {
 	Content string
 	Author  string
 	Slug    string
+	Tags    []string
+	Status  string
 	Confirm bool
 }
 
@@ -68,7 +70,7 @@ func NewPostForm(initialData PostFormData, useEditor bool) (*PostFormData, error
 	slugInput := huh.NewInput().
 		Value(&data.Slug).
 		Title("URL Slug").
-		Description("The URL-friendly version of the title (optional)").
+		Description("Leave empty to make one from the title").
 		Placeholder("my-awesome-post")
 
 	confirmInput := huh.NewConfirm().
@@ -107,22 +109,30 @@ func NewPostForm(initialData PostFormData, useEditor bool) (*PostFormData, error
 			return nil, fmt.Errorf("editor not available: %s", ed.GetEditorInfo())
 		}
 
-		editedContent, err := ed.EditContentWithTemplate(data.Title, data.Author, data.Content, false)
+		edited, err := ed.EditContentWithTemplate(editor.Post{
+			Title:   data.Title,
+			Author:  data.Author,
+			Slug:    data.Slug,
+			Status:  string(database.StatusDraft),
+			Content: data.Content,
+		}, false)
 		if err != nil {
 			return nil, fmt.Errorf("failed to edit content: %w", err)
 		}
 
-		data.Content = editedContent
+		data.Title = edited.Title
+		data.Author = edited.Author
+		data.Slug = edited.Slug
+		data.Tags = edited.Tags
+		data.Status = edited.Status
+		data.Content = edited.Content
 
-		if data.Content == "" {
+		if strings.TrimSpace(data.Content) == "" {
 			return nil, fmt.Errorf("content cannot be empty")
 		}
 	}
 
-	// Auto-generate slug if not provided
-	if strings.TrimSpace(data.Slug) == "" {
-		data.Slug = generateSlug(data.Title)
-	}
+	data.Slug = strings.TrimSpace(data.Slug)
 
 	return &data, nil
 }
@@ -136,29 +146,3 @@ func (data *PostFormData) ToPost() database.Post {
 		data.Slug,
 	)
 }
-
-// generateSlug creates a URL-friendly slug from a title
-func generateSlug(title string) string {
-	// Convert to lowercase and replace spaces with hyphens
-	slug := strings.ToLower(title)
-	slug = strings.ReplaceAll(slug, " ", "-")
-	
-	// Remove special characters (keep only letters, numbers, and hyphens)
-	var result strings.Builder
-	for _, r := range slug {
-		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
-			result.WriteRune(r)
-		}
-	}
-	
-	// Clean up multiple consecutive hyphens
-	slug = result.String()
-	for strings.Contains(slug, "--") {
-		slug = strings.ReplaceAll(slug, "--", "-")
-	}
-	
-	// Trim hyphens from start and end
-	slug = strings.Trim(slug, "-")
-	
-	return slug
-}
\ No newline at end of file
@@ -71,67 +71,6 @@ func TestPostFormData_ToPost(t *testing.T) {
 	}
 }
 
-func TestGenerateSlug(t *testing.T) {
-	tests := []struct {
-		name     string
-		title    string
-		expected string
-	}{
-		{
-			name:     "Simple title",
-			title:    "Hello World",
-			expected: "hello-world",
-		},
-		{
-			name:     "Title with special characters",
-			title:    "Hello, World! & More #stuff",
-			expected: "hello-world-more-stuff",
-		},
-		{
-			name:     "Title with numbers",
-			title:    "Top 10 Tips for 2024",
-			expected: "top-10-tips-for-2024",
-		},
-		{
-			name:     "Title with multiple spaces",
-			title:    "Too    Many     Spaces",
-			expected: "too-many-spaces",
-		},
-		{
-			name:     "Title with leading/trailing spaces",
-			title:    "  Trimmed Title  ",
-			expected: "trimmed-title",
-		},
-		{
-			name:     "Empty title",
-			title:    "",
-			expected: "",
-		},
-		{
-			name:     "Only special characters",
-			title:    "!@#$%^&*()",
-			expected: "",
-		},
-		{
-			name:     "Unicode characters",
-			title:    "Héllo Wörld",
-			expected: "hllo-wrld", // Non-ASCII chars are removed
-		},
-		{
-			name:     "Consecutive hyphens",
-			title:    "Word---With---Hyphens",
-			expected: "word-with-hyphens",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := generateSlug(tt.title)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestPostFormDataValidation(t *testing.T) {
 	// Test that form data can be created and used correctly
 	formData := PostFormData{
@@ -224,13 +163,3 @@ func BenchmarkPostFormDataToPost(b *testing.B) {
 		_ = post // Use the result to prevent optimization
 	}
 }
-
-func BenchmarkGenerateSlug(b *testing.B) {
-	title := "This is a Title With Multiple Words and Some Numbers 123"
-	
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		slug := generateSlug(title)
-		_ = slug // Use the result to prevent optimization
-	}
-}
\ No newline at end of file
@@ -0,0 +1,203 @@
+package forms
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/editor"
+	"github.com/dreamsofcode-io/cli-cms/internal/slug"
+)
+
+// NewUpdateForm creates an interactive form for changing a post. current is
+// the post as it is saved, and initialData prefills the form. If useEditor is
+// true, the content can be changed in the text editor after the form,
+// otherwise it is edited in a text area. The confirm step summarises what will
+// change from current. Changing the slug makes the old one redirect to the post
+func NewUpdateForm(current, initialData PostFormData, useEditor bool) (*PostFormData, error) {
+	data := initialData
+	data.Tags = slices.Clone(initialData.Tags)
+	data.Confirm = false
+
+	tags := strings.Join(initialData.Tags, ", ")
+	editContent := false
+
+	titleInput := huh.NewInput().
+		Value(&data.Title).
+		Title("Post Title").
+		Description("The title of your blog post").
+		Validate(func(s string) error {
+			if strings.TrimSpace(s) == "" {
+				return fmt.Errorf("title is required")
+			}
+			return nil
+		})
+
+	authorInput := huh.NewInput().
+		Value(&data.Author).
+		Title("Author").
+		Description("The author of this blog post")
+
+	slugInput := huh.NewInput().
+		Value(&data.Slug).
+		Title("URL Slug").
+		Description("Changing it redirects the old slug to the post").
+		Validate(func(s string) error {
+			s = strings.TrimSpace(s)
+			if s == "" || s == current.Slug {
+				return nil
+			}
+			return slug.Validate(s)
+		})
+
+	tagsInput := huh.NewInput().
+		Value(&tags).
+		Title("Tags").
+		Description("Comma-separated, leave empty for no tags").
+		Placeholder("go, cli")
+
+	statusInput := huh.NewSelect[string]().
+		Value(&data.Status).
+		Title("Status").
+		Options(statusOptions(current.Status)...)
+
+	var contentInput huh.Field
+	if useEditor {
+		contentInput = huh.NewConfirm().
+			Value(&editContent).
+			Title("Edit the content?").
+			Description("The content will open in your text editor after this form")
+	} else {
+		contentInput = huh.NewText().
+			Value(&data.Content).
+			Title("Post Content").
+			Description("The content of your blog post")
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			titleInput,
+			authorInput,
+			slugInput,
+			tagsInput,
+			statusInput,
+			contentInput,
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return nil, err
+	}
+
+	data.Title = strings.TrimSpace(data.Title)
+	data.Author = strings.TrimSpace(data.Author)
+	data.Slug = strings.TrimSpace(data.Slug)
+	if data.Slug == "" {
+		data.Slug = current.Slug
+	}
+	data.Tags = splitTags(tags)
+
+	// Open editor for content if requested
+	if editContent {
+		ed := editor.New()
+		if !ed.IsAvailable() {
+			return nil, fmt.Errorf("editor not available: %s", ed.GetEditorInfo())
+		}
+
+		content, err := ed.EditContent(data.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to edit content: %w", err)
+		}
+		data.Content = content
+	}
+
+	summary := ChangeSummary(current, data)
+	if summary == "" {
+		return nil, ErrNoChanges
+	}
+
+	confirmInput := huh.NewConfirm().
+		Title("Save these changes?").
+		Description(summary).
+		Value(&data.Confirm)
+
+	if err := huh.NewForm(huh.NewGroup(confirmInput)).Run(); err != nil {
+		return nil, err
+	}
+
+	if !data.Confirm {
+		return nil, ErrUserCancelled
+	}
+
+	return &data, nil
+}
+
+// ChangeSummary lists the fields that differ between before and after, with
+// their old and new values. It is empty when nothing changed
+func ChangeSummary(before, after PostFormData) string {
+	var lines []string
+
+	change := func(label, old, new string) {
+		if old == new {
+			return
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s → %s", label, summaryValue(old), summaryValue(new)))
+	}
+
+	change("Title", before.Title, after.Title)
+	change("Author", before.Author, after.Author)
+	change("Slug", before.Slug, after.Slug)
+	change("Tags", strings.Join(before.Tags, ", "), strings.Join(after.Tags, ", "))
+	change("Status", before.Status, after.Status)
+
+	if before.Content != after.Content {
+		lines = append(lines, fmt.Sprintf("Content: %d → %d lines, %d → %d characters",
+			lineCount(before.Content), lineCount(after.Content),
+			len([]rune(before.Content)), len([]rune(after.Content)),
+		))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// statusOptions lists the statuses a post can be given from the form. A
+// scheduled post can keep its schedule, but can't be scheduled from here
+func statusOptions(current string) []huh.Option[string] {
+	statuses := []database.PostStatus{database.StatusDraft, database.StatusPublished, database.StatusArchived}
+	if database.PostStatus(current) == database.StatusScheduled {
+		statuses = append(statuses, database.StatusScheduled)
+	}
+
+	options := make([]huh.Option[string], len(statuses))
+	for i, status := range statuses {
+		options[i] = huh.NewOption(string(status), string(status))
+	}
+	return options
+}
+
+// splitTags parses a comma-separated list of tags
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func summaryValue(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+func lineCount(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(s, "\n") + 1
+}
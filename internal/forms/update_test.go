@@ -0,0 +1,82 @@
+package forms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeSummary(t *testing.T) {
+	before := PostFormData{
+		Title:   "Old Title",
+		Author:  "Jane",
+		Slug:    "old-title",
+		Tags:    []string{"go"},
+		Status:  "draft",
+		Content: "one line",
+	}
+
+	tests := []struct {
+		name   string
+		change func(*PostFormData)
+		want   string
+	}{
+		{
+			name:   "No changes",
+			change: func(*PostFormData) {},
+			want:   "",
+		},
+		{
+			name:   "Title",
+			change: func(d *PostFormData) { d.Title = "New Title" },
+			want:   `Title: "Old Title" → "New Title"`,
+		},
+		{
+			name:   "Cleared author",
+			change: func(d *PostFormData) { d.Author = "" },
+			want:   `Author: "Jane" → (none)`,
+		},
+		{
+			name:   "Slug",
+			change: func(d *PostFormData) { d.Slug = "new-title" },
+			want:   `Slug: "old-title" → "new-title"`,
+		},
+		{
+			name:   "Tags and status",
+			change: func(d *PostFormData) { d.Tags = []string{"go", "cli"}; d.Status = "published" },
+			want:   "Tags: \"go\" → \"go, cli\"\nStatus: \"draft\" → \"published\"",
+		},
+		{
+			name:   "Content",
+			change: func(d *PostFormData) { d.Content = "two\nlines" },
+			want:   "Content: 1 → 2 lines, 8 → 9 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := before
+			after.Tags = append([]string(nil), before.Tags...)
+			tt.change(&after)
+
+			assert.Equal(t, tt.want, ChangeSummary(before, after))
+		})
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	assert.Equal(t, []string{"go", "cli"}, splitTags(" go, ,cli ,"))
+	assert.Nil(t, splitTags(""))
+}
+
+func TestStatusOptions(t *testing.T) {
+	assert.Len(t, statusOptions("draft"), 3)
+
+	options := statusOptions("scheduled")
+	assert.Len(t, options, 4)
+	assert.Equal(t, "scheduled", options[3].Value)
+}
+
+func TestErrNoChanges(t *testing.T) {
+	assert.Equal(t, "nothing was changed", ErrNoChanges.Error())
+}
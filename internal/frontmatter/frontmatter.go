@@ -0,0 +1,81 @@
+package frontmatter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Delimiter marks the start and end of a frontmatter block
+const Delimiter = "---"
+
+// ErrMissing is returned when a document doesn't start with a frontmatter block
+var ErrMissing = errors.New("missing frontmatter")
+
+// Marshal writes meta as a YAML frontmatter block followed by a blank line and the body
+func Marshal(meta any, body string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(Delimiter + "\n")
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(meta); err != nil {
+		return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode frontmatter: %w", err)
+	}
+
+	buf.WriteString(Delimiter + "\n\n")
+	buf.WriteString(body)
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes the frontmatter block at the start of data into meta and
+// returns the body that follows it. The blank line written by Marshal between
+// the block and the body is removed, so Marshal and Unmarshal round-trip the
+// body exactly. Unknown frontmatter keys are reported as errors
+func Unmarshal(data []byte, meta any) (string, error) {
+	front, body, err := Split(string(data))
+	if err != nil {
+		return "", err
+	}
+
+	decoder := yaml.NewDecoder(strings.NewReader(front))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(meta); err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("invalid frontmatter: %w", err)
+	}
+
+	return body, nil
+}
+
+// Split separates a document into its raw frontmatter and body. A single
+// blank line after the closing delimiter is treated as part of the delimiter
+func Split(document string) (string, string, error) {
+	first, rest, found := strings.Cut(document, "\n")
+	if !found || strings.TrimRight(first, "\r") != Delimiter {
+		return "", "", ErrMissing
+	}
+
+	var front strings.Builder
+	for rest != "" {
+		line, remaining, _ := strings.Cut(rest, "\n")
+		if strings.TrimRight(line, "\r") == Delimiter {
+			if strings.HasPrefix(remaining, "\r\n") {
+				return front.String(), remaining[2:], nil
+			}
+			return front.String(), strings.TrimPrefix(remaining, "\n"), nil
+		}
+
+		front.WriteString(line + "\n")
+		rest = remaining
+	}
+
+	return "", "", fmt.Errorf("%w: no closing %q line", ErrMissing, Delimiter)
+}
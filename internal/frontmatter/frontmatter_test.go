@@ -0,0 +1,104 @@
+package frontmatter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testMeta struct {
+	Title string   `yaml:"title"`
+	Tags  []string `yaml:"tags,omitempty"`
+}
+
+func TestMarshal(t *testing.T) {
+	data, err := Marshal(testMeta{Title: "Hello", Tags: []string{"go"}}, "# Heading\n\nBody\n")
+	require.NoError(t, err)
+
+	assert.Equal(t, "---\ntitle: Hello\ntags:\n  - go\n---\n\n# Heading\n\nBody\n", string(data))
+}
+
+func TestRoundTrip(t *testing.T) {
+	bodies := []string{
+		"",
+		"Body",
+		"\nStarts with a blank line",
+		"## Heading\n\n---\n\nAfter a rule\n\n\n",
+		"windows\r\nline endings\r\n",
+	}
+
+	for _, body := range bodies {
+		data, err := Marshal(testMeta{Title: "Round trip"}, body)
+		require.NoError(t, err)
+
+		var meta testMeta
+		got, err := Unmarshal(data, &meta)
+		require.NoError(t, err)
+		assert.Equal(t, body, got)
+		assert.Equal(t, "Round trip", meta.Title)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		wantMeta testMeta
+		wantBody string
+		wantErr  error
+	}{
+		{
+			name:     "no blank line before body",
+			document: "---\ntitle: Hi\n---\nBody",
+			wantMeta: testMeta{Title: "Hi"},
+			wantBody: "Body",
+		},
+		{
+			name:     "crlf",
+			document: "---\r\ntitle: Hi\r\n---\r\n\r\nBody",
+			wantMeta: testMeta{Title: "Hi"},
+			wantBody: "Body",
+		},
+		{
+			name:     "empty block",
+			document: "---\n---\nBody",
+			wantBody: "Body",
+		},
+		{
+			name:     "closing delimiter at end of file",
+			document: "---\ntitle: Hi\n---",
+			wantMeta: testMeta{Title: "Hi"},
+		},
+		{
+			name:     "missing",
+			document: "# Just markdown",
+			wantErr:  ErrMissing,
+		},
+		{
+			name:     "unclosed",
+			document: "---\ntitle: Hi\n",
+			wantErr:  ErrMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var meta testMeta
+			body, err := Unmarshal([]byte(tt.document), &meta)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMeta, meta)
+			assert.Equal(t, tt.wantBody, body)
+		})
+	}
+}
+
+func TestUnmarshalUnknownField(t *testing.T) {
+	var meta testMeta
+	_, err := Unmarshal([]byte("---\ntitel: Typo\n---\n"), &meta)
+	assert.Error(t, err)
+}
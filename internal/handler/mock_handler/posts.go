@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: posts.go
+//
+// Generated by this command:
+//
+//	mockgen -source=posts.go -destination=mock_handler/posts.go
+//
+
+// Package mock_handler is a generated GoMock package.
+package mock_handler
+
+import (
+	reflect "reflect"
+
+	editor "github.com/dreamsofcode-io/cli-cms/internal/editor"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTextEditor is a mock of TextEditor interface.
+type MockTextEditor struct {
+	ctrl     *gomock.Controller
+	recorder *MockTextEditorMockRecorder
+	isgomock struct{}
+}
+
+// MockTextEditorMockRecorder is the mock recorder for MockTextEditor.
+type MockTextEditorMockRecorder struct {
+	mock *MockTextEditor
+}
+
+// NewMockTextEditor creates a new mock instance.
+func NewMockTextEditor(ctrl *gomock.Controller) *MockTextEditor {
+	mock := &MockTextEditor{ctrl: ctrl}
+	mock.recorder = &MockTextEditorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTextEditor) EXPECT() *MockTextEditorMockRecorder {
+	return m.recorder
+}
+
+// EditContentWithTemplate mocks base method.
+func (m *MockTextEditor) EditContentWithTemplate(post editor.Post, isUpdate bool) (*editor.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditContentWithTemplate", post, isUpdate)
+	ret0, _ := ret[0].(*editor.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditContentWithTemplate indicates an expected call of EditContentWithTemplate.
+func (mr *MockTextEditorMockRecorder) EditContentWithTemplate(post, isUpdate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditContentWithTemplate", reflect.TypeOf((*MockTextEditor)(nil).EditContentWithTemplate), post, isUpdate)
+}
+
+// GetEditorInfo mocks base method.
+func (m *MockTextEditor) GetEditorInfo() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEditorInfo")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetEditorInfo indicates an expected call of GetEditorInfo.
+func (mr *MockTextEditorMockRecorder) GetEditorInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEditorInfo", reflect.TypeOf((*MockTextEditor)(nil).GetEditorInfo))
+}
+
+// IsAvailable mocks base method.
+func (m *MockTextEditor) IsAvailable() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAvailable")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsAvailable indicates an expected call of IsAvailable.
+func (mr *MockTextEditorMockRecorder) IsAvailable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAvailable", reflect.TypeOf((*MockTextEditor)(nil).IsAvailable))
+}
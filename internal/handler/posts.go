@@ -4,22 +4,26 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
 	"github.com/dreamsofcode-io/cli-cms/internal/editor"
+	"github.com/dreamsofcode-io/cli-cms/internal/forms"
 )
 
 // TextEditor interface defines the methods needed for text editing
 type TextEditor interface {
-	EditContentWithTemplate(title, author, existingContent string, isUpdate bool) (string, error)
+	EditContentWithTemplate(post editor.Post, isUpdate bool) (*editor.Post, error)
 	IsAvailable() bool
 	GetEditorInfo() string
 }
 
 // Posts handles post-related operations
 type Posts struct {
-	db         *database.Database
+	db         database.Storage
 	textEditor TextEditor
 }
 
@@ -34,7 +38,7 @@ func WithTextEditor(editor TextEditor) Option {
 }
 
 // NewPosts creates a new Posts handler with optional configuration
-func NewPosts(db *database.Database, opts ...Option) *Posts {
+func NewPosts(db database.Storage, opts ...Option) *Posts {
 	res := &Posts{
 		db:         db,
 		textEditor: editor.New(),
@@ -47,35 +51,52 @@ func NewPosts(db *database.Database, opts ...Option) *Posts {
 	return res
 }
 
-// CreatePost creates a new blog post
+// CreatePost creates a new blog post. With useEditor set, the title, author
+// and slug prefill the editor template, and the edited fields, tags and status
+// are applied to the new post
 func (p *Posts) CreatePost(ctx context.Context, title, author, slug string, useEditor bool) (*database.Post, error) {
-	var content string
-	
-	if useEditor {
-		if !p.textEditor.IsAvailable() {
-			return nil, fmt.Errorf("editor not available: %s", p.textEditor.GetEditorInfo())
-		}
-		
-		editedContent, err := p.textEditor.EditContentWithTemplate(title, author, "", false)
-		if err != nil {
-			return nil, fmt.Errorf("failed to edit content: %w", err)
-		}
-		
-		content = editedContent
-		
-		if content == "" {
-			return nil, fmt.Errorf("content cannot be empty when using editor")
-		}
+	if !useEditor {
+		return p.CreatePostWithContent(ctx, title, "", author, slug)
+	}
+
+	return p.CreatePostInEditor(ctx, editor.Post{
+		Title:  title,
+		Author: author,
+		Slug:   slug,
+		Status: string(database.StatusDraft),
+	})
+}
+
+// CreatePostInEditor opens the editor with a prefilled template and creates a
+// post from the edited fields, tags and status
+func (p *Posts) CreatePostInEditor(ctx context.Context, template editor.Post) (*database.Post, error) {
+	if !p.textEditor.IsAvailable() {
+		return nil, fmt.Errorf("editor not available: %s", p.textEditor.GetEditorInfo())
+	}
+
+	edited, err := p.textEditor.EditContentWithTemplate(template, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit content: %w", err)
 	}
 	
-	// Create the post using helper function
-	post := database.CreatePostFromInput(title, content, author, slug)
-	
-	createdPost, err := p.db.CreatePost(ctx, post)
+	if strings.TrimSpace(edited.Content) == "" {
+		return nil, fmt.Errorf("content cannot be empty when using editor")
+	}
+
+	if err := ValidateStatus(edited.Status); err != nil {
+		return nil, err
+	}
+
+	// The post is created with its status and tags in one go, so a failure
+	// doesn't leave a half made post behind
+	post := database.CreatePostFromInput(edited.Title, edited.Content, edited.Author, edited.Slug)
+	setNewPostStatus(&post, edited.Status)
+
+	createdPost, _, err := p.db.CreatePostWithTags(ctx, post, edited.Tags)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create post: %w", err)
 	}
-	
+
 	return createdPost, nil
 }
 
@@ -90,4 +111,132 @@ func (p *Posts) CreatePostWithContent(ctx context.Context, title, content, autho
 	}
 	
 	return createdPost, nil
-}
\ No newline at end of file
+}
+
+// CreatePostFromForm creates a post from a completed post form, applying the
+// tags and status it was given
+func (p *Posts) CreatePostFromForm(ctx context.Context, data *forms.PostFormData) (*database.Post, error) {
+	if err := ValidateStatus(data.Status); err != nil {
+		return nil, err
+	}
+
+	post := data.ToPost()
+	setNewPostStatus(&post, data.Status)
+
+	createdPost, _, err := p.db.CreatePostWithTags(ctx, post, data.Tags)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create post: %w", err)
+	}
+
+	return createdPost, nil
+}
+
+// EditPost opens a post in the editor template and saves the edited title,
+// author, slug, content, tags and status. Changing the slug makes the old one
+// redirect to the post
+func (p *Posts) EditPost(ctx context.Context, post *database.Post) (*database.Post, error) {
+	return p.EditPostWithTags(ctx, post, nil)
+}
+
+// EditPostWithTags edits a post like EditPost, prefilling the template with
+// tags instead of the post's current tags unless tags is nil. The fields of
+// post prefill the template too, so changes can be made before it opens. The
+// edits are saved in one transaction, leaving the post as it was on error
+func (p *Posts) EditPostWithTags(ctx context.Context, post *database.Post, tags []string) (*database.Post, error) {
+	if !p.textEditor.IsAvailable() {
+		return nil, fmt.Errorf("editor not available: %s", p.textEditor.GetEditorInfo())
+	}
+
+	if tags == nil {
+		current, err := p.db.GetPostTags(ctx, int(post.ID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags: %w", err)
+		}
+		for _, tag := range current {
+			tags = append(tags, tag.Name)
+		}
+	}
+
+	template := editor.Post{
+		Title:   post.Title,
+		Author:  database.NullStringToString(post.Author),
+		Slug:    database.NullStringToString(post.Slug),
+		Status:  post.Status,
+		Content: database.NullStringToString(post.Content),
+		Tags:    tags,
+	}
+
+	edited, err := p.textEditor.EditContentWithTemplate(template, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit content: %w", err)
+	}
+
+	if err := ValidateStatus(edited.Status); err != nil {
+		return nil, err
+	}
+
+	updates := *post
+	updates.Title = edited.Title
+	updates.Author = database.StringToNullString(edited.Author)
+	updates.Slug = database.StringToNullString(edited.Slug)
+	updates.Content = database.StringToNullString(edited.Content)
+
+	updates.Status = edited.Status
+
+	// Tags removed from the template are removed from the post, so they are
+	// always replaced, even with none
+	updatedPost, _, err := p.db.UpdatePostWithTags(ctx, int(post.ID), updates, append([]string{}, edited.Tags...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update post: %w", err)
+	}
+
+	return updatedPost, nil
+}
+
+// setNewPostStatus gives a post that is about to be created its status, with
+// a publish date of now when it is published
+func setNewPostStatus(post *database.Post, status string) {
+	post.Status = status
+	if database.PostStatus(status) == database.StatusPublished {
+		post.PublishedAt = database.TimeToNullTime(time.Now().UTC())
+	}
+}
+
+// ValidateStatus checks a status that was typed into the editor template.
+// Scheduling needs a publish time, so it is left to the schedule command
+func ValidateStatus(status string) error {
+	switch database.PostStatus(status) {
+	case "", database.StatusDraft, database.StatusPublished, database.StatusArchived:
+		return nil
+	case database.StatusScheduled:
+		return errors.New("posts cannot be scheduled from the editor, use \"cms posts schedule\" instead")
+	}
+	return fmt.Errorf("invalid status %q (must be one of draft, published, archived)", status)
+}
+
+// SetStatus moves a post to draft, published or archived. An empty status or
+// the post's current status leaves it unchanged
+func (p *Posts) SetStatus(ctx context.Context, post *database.Post, status string) (*database.Post, error) {
+	if err := ValidateStatus(status); err != nil {
+		return nil, err
+	}
+
+	if status == "" || status == post.Status {
+		return post, nil
+	}
+
+	var err error
+	switch database.PostStatus(status) {
+	case database.StatusDraft:
+		post, err = p.db.UnpublishPost(ctx, int(post.ID))
+	case database.StatusPublished:
+		post, err = p.db.PublishPost(ctx, int(post.ID))
+	case database.StatusArchived:
+		post, err = p.db.ArchivePost(ctx, int(post.ID))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set status: %w", err)
+	}
+
+	return post, nil
+}
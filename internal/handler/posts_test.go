@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/editor"
+	"github.com/dreamsofcode-io/cli-cms/internal/forms"
 	"github.com/dreamsofcode-io/cli-cms/internal/handler/mock_handler"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -34,6 +36,15 @@ func setupTestDB(t *testing.T) (*database.Database, func()) {
 	return db, cleanup
 }
 
+// createAuthors adds the authors a test's posts are written by
+func createAuthors(t *testing.T, db *database.Database, names ...string) {
+	t.Helper()
+	for _, name := range names {
+		_, err := db.CreateAuthor(context.Background(), database.Author{Name: name})
+		require.NoError(t, err)
+	}
+}
+
 func TestPosts_CreatePost(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -41,7 +52,7 @@ func TestPosts_CreatePost(t *testing.T) {
 		author      string
 		slug        string
 		useEditor   bool
-		editorResp  string
+		editorResp  *editor.Post
 		editorErr   error
 		wantErr     bool
 		errContains string
@@ -52,9 +63,15 @@ func TestPosts_CreatePost(t *testing.T) {
 			author:     "Test Author",
 			slug:       "test-post",
 			useEditor:  true,
-			editorResp: "This is test content from editor",
-			editorErr:  nil,
-			wantErr:    false,
+			editorResp: &editor.Post{
+				Title:   "Test Post",
+				Author:  "Test Author",
+				Slug:    "test-post",
+				Status:  "draft",
+				Content: "This is test content from editor",
+			},
+			editorErr: nil,
+			wantErr:   false,
 		},
 		{
 			name:        "Create post with editor failure",
@@ -62,7 +79,7 @@ func TestPosts_CreatePost(t *testing.T) {
 			author:      "Test Author",
 			slug:        "test-post",
 			useEditor:   true,
-			editorResp:  "",
+			editorResp:  nil,
 			editorErr:   errors.New("editor failed"),
 			wantErr:     true,
 			errContains: "failed to edit content",
@@ -73,11 +90,22 @@ func TestPosts_CreatePost(t *testing.T) {
 			author:      "Test Author",
 			slug:        "test-post",
 			useEditor:   true,
-			editorResp:  "",
+			editorResp:  &editor.Post{Title: "Test Post", Status: "draft"},
 			editorErr:   nil,
 			wantErr:     true,
 			errContains: "content cannot be empty",
 		},
+		{
+			name:        "Create post with editor returning a scheduled status",
+			title:       "Test Post",
+			author:      "Test Author",
+			slug:        "test-post",
+			useEditor:   true,
+			editorResp:  &editor.Post{Title: "Test Post", Status: "scheduled", Content: "Content"},
+			editorErr:   nil,
+			wantErr:     true,
+			errContains: "cannot be scheduled",
+		},
 		{
 			name:      "Create post without editor",
 			title:     "Test Post",
@@ -96,14 +124,19 @@ func TestPosts_CreatePost(t *testing.T) {
 
 			db, cleanup := setupTestDB(t)
 			defer cleanup()
+			createAuthors(t, db, "Test Author")
 
 			mockEditor := mock_handler.NewMockTextEditor(ctrl)
 			
 			if tt.useEditor {
 				// Set expectations for editor calls
 				mockEditor.EXPECT().IsAvailable().Return(true)
-				mockEditor.EXPECT().EditContentWithTemplate(tt.title, tt.author, "", false).
-					Return(tt.editorResp, tt.editorErr)
+				mockEditor.EXPECT().EditContentWithTemplate(editor.Post{
+					Title:  tt.title,
+					Author: tt.author,
+					Slug:   tt.slug,
+					Status: "draft",
+				}, false).Return(tt.editorResp, tt.editorErr)
 			}
 
 			// Create handler with mock editor
@@ -128,7 +161,7 @@ func TestPosts_CreatePost(t *testing.T) {
 				assert.Equal(t, tt.slug, createdPost.Slug.String)
 				
 				if tt.useEditor {
-					assert.Equal(t, tt.editorResp, createdPost.Content.String)
+					assert.Equal(t, tt.editorResp.Content, createdPost.Content.String)
 				}
 				
 				// Verify post was saved to database
@@ -175,6 +208,7 @@ func TestPosts_CreatePostWithContent(t *testing.T) {
 
 			db, cleanup := setupTestDB(t)
 			defer cleanup()
+			createAuthors(t, db, "Test Author")
 
 			// No need for editor mock since this method doesn't use it
 			handler := NewPosts(db)
@@ -262,6 +296,7 @@ func TestPosts_CreatePost_MockInputValidation(t *testing.T) {
 
 	db, cleanup := setupTestDB(t)
 	defer cleanup()
+	createAuthors(t, db, "Specific Author")
 
 	mockEditor := mock_handler.NewMockTextEditor(ctrl)
 	
@@ -273,11 +308,14 @@ func TestPosts_CreatePost_MockInputValidation(t *testing.T) {
 	
 	mockEditor.EXPECT().IsAvailable().Return(true)
 	mockEditor.EXPECT().EditContentWithTemplate(
-		gomock.Eq(title),    // Exact title match
-		gomock.Eq(author),   // Exact author match  
-		gomock.Eq(""),       // Empty existing content
-		gomock.Eq(false),    // Not an update
-	).Return(expectedContent, nil)
+		gomock.Eq(editor.Post{ // Exact prefilled fields
+			Title:  title,
+			Author: author,
+			Slug:   slug,
+			Status: "draft",
+		}),
+		gomock.Eq(false), // Not an update
+	).Return(&editor.Post{Title: title, Author: author, Slug: slug, Status: "draft", Content: expectedContent}, nil)
 
 	// Create handler with mock editor
 	handler := NewPosts(db, WithTextEditor(mockEditor))
@@ -290,4 +328,200 @@ func TestPosts_CreatePost_MockInputValidation(t *testing.T) {
 	assert.NoError(t, err)
 	require.NotNil(t, createdPost)
 	assert.Equal(t, expectedContent, createdPost.Content.String)
-}
\ No newline at end of file
+}
+func TestPosts_CreatePost_AppliesEditedFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	createAuthors(t, db, "Original Author", "Edited Author")
+
+	mockEditor := mock_handler.NewMockTextEditor(ctrl)
+	mockEditor.EXPECT().IsAvailable().Return(true)
+	mockEditor.EXPECT().EditContentWithTemplate(gomock.Any(), false).Return(&editor.Post{
+		Title:   "Edited Title",
+		Author:  "Edited Author",
+		Slug:    "edited-slug",
+		Tags:    []string{"Go", "cli"},
+		Status:  "published",
+		Content: "Edited content",
+	}, nil)
+
+	handler := NewPosts(db, WithTextEditor(mockEditor))
+
+	ctx := context.Background()
+	createdPost, err := handler.CreatePost(ctx, "Original Title", "Original Author", "original-slug", true)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Edited Title", createdPost.Title)
+	assert.Equal(t, "Edited Author", createdPost.Author.String)
+	assert.Equal(t, "edited-slug", createdPost.Slug.String)
+	assert.Equal(t, "Edited content", createdPost.Content.String)
+	assert.Equal(t, string(database.StatusPublished), createdPost.Status)
+	assert.True(t, createdPost.PublishedAt.Valid)
+
+	tags, err := db.GetPostTags(ctx, int(createdPost.ID))
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, "cli", tags[0].Name)
+	assert.Equal(t, "go", tags[1].Name)
+}
+
+func TestPosts_CreatePostFromForm(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	createAuthors(t, db, "Form Author")
+
+	ctx := context.Background()
+	handler := NewPosts(db)
+
+	post, err := handler.CreatePostFromForm(ctx, &forms.PostFormData{
+		Title:   "Form Post",
+		Content: "Form content",
+		Author:  "Form Author",
+		Slug:    "form-post",
+		Tags:    []string{"go"},
+		Status:  "published",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Form Post", post.Title)
+	assert.Equal(t, string(database.StatusPublished), post.Status)
+
+	tags, err := db.GetPostTags(ctx, int(post.ID))
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "go", tags[0].Name)
+
+	_, err = handler.CreatePostFromForm(ctx, &forms.PostFormData{Title: "Bad", Status: "bogus"})
+	assert.Error(t, err)
+}
+
+func TestPosts_EditPost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	createAuthors(t, db, "Edited Author")
+
+	ctx := context.Background()
+	post, _, err := db.GetPostBySlug(ctx, "welcome-to-cms")
+	require.NoError(t, err)
+	_, err = db.SetPostTags(ctx, int(post.ID), []string{"intro"})
+	require.NoError(t, err)
+
+	mockEditor := mock_handler.NewMockTextEditor(ctrl)
+	mockEditor.EXPECT().IsAvailable().Return(true)
+	mockEditor.EXPECT().EditContentWithTemplate(gomock.Any(), true).DoAndReturn(
+		func(template editor.Post, isUpdate bool) (*editor.Post, error) {
+			// The template is prefilled from the post
+			assert.Equal(t, post.Title, template.Title)
+			assert.Equal(t, "welcome-to-cms", template.Slug)
+			assert.Equal(t, []string{"intro"}, template.Tags)
+			assert.Equal(t, post.Content.String, template.Content)
+
+			return &editor.Post{
+				Title:   "Edited Title",
+				Author:  "Edited Author",
+				Slug:    "edited-slug",
+				Tags:    []string{"go"},
+				Status:  "archived",
+				Content: "Edited content",
+			}, nil
+		})
+
+	handler := NewPosts(db, WithTextEditor(mockEditor))
+
+	edited, err := handler.EditPost(ctx, post)
+	require.NoError(t, err)
+	assert.Equal(t, post.ID, edited.ID)
+	assert.Equal(t, "Edited Title", edited.Title)
+	assert.Equal(t, "Edited Author", edited.Author.String)
+	assert.Equal(t, "edited-slug", edited.Slug.String)
+	assert.Equal(t, "Edited content", edited.Content.String)
+	assert.Equal(t, string(database.StatusArchived), edited.Status)
+
+	// The old slug redirects to the post
+	found, redirected, err := db.GetPostBySlug(ctx, "welcome-to-cms")
+	require.NoError(t, err)
+	assert.True(t, redirected)
+	assert.Equal(t, post.ID, found.ID)
+
+	tags, err := db.GetPostTags(ctx, int(post.ID))
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.Equal(t, "go", tags[0].Name)
+}
+
+func TestPosts_RejectsUnknownEditedAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	post, _, err := db.GetPostBySlug(ctx, "welcome-to-cms")
+	require.NoError(t, err)
+
+	// The author was checked before the editor opened, but was then changed
+	// in the frontmatter
+	edited := &editor.Post{
+		Title:   "Edited Title",
+		Author:  "Nobody",
+		Status:  "published",
+		Content: "Edited content",
+	}
+
+	mockEditor := mock_handler.NewMockTextEditor(ctrl)
+	mockEditor.EXPECT().IsAvailable().Return(true).Times(2)
+	mockEditor.EXPECT().EditContentWithTemplate(gomock.Any(), false).Return(edited, nil)
+	mockEditor.EXPECT().EditContentWithTemplate(gomock.Any(), true).Return(edited, nil)
+
+	handler := NewPosts(db, WithTextEditor(mockEditor))
+
+	_, err = handler.CreatePost(ctx, "New Post", "CMS Admin", "", true)
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+
+	_, err = handler.EditPost(ctx, post)
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound)
+
+	unchanged, err := db.GetPostByID(ctx, int(post.ID))
+	require.NoError(t, err)
+	assert.Equal(t, post.Title, unchanged.Title)
+	assert.Equal(t, post.Author, unchanged.Author)
+
+	_, err = db.GetAuthorByName(ctx, "Nobody")
+	assert.ErrorIs(t, err, database.ErrAuthorNotFound, "the author isn't created")
+}
+
+func TestPosts_SetStatus(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	createAuthors(t, db, "Author")
+
+	ctx := context.Background()
+	handler := NewPosts(db)
+
+	post, err := handler.CreatePostWithContent(ctx, "Status Post", "Content", "Author", "status-post")
+	require.NoError(t, err)
+
+	post, err = handler.SetStatus(ctx, post, "published")
+	require.NoError(t, err)
+	assert.Equal(t, string(database.StatusPublished), post.Status)
+
+	post, err = handler.SetStatus(ctx, post, "")
+	require.NoError(t, err)
+	assert.Equal(t, string(database.StatusPublished), post.Status)
+
+	post, err = handler.SetStatus(ctx, post, "archived")
+	require.NoError(t, err)
+	assert.Equal(t, string(database.StatusArchived), post.Status)
+
+	_, err = handler.SetStatus(ctx, post, "scheduled")
+	assert.Error(t, err)
+
+	_, err = handler.SetStatus(ctx, post, "bogus")
+	assert.Error(t, err)
+}
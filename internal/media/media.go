@@ -0,0 +1,272 @@
+package media
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // GIF dimensions
+	_ "image/jpeg" // JPEG dimensions
+	_ "image/png"  // PNG dimensions
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	// Scheme starts a reference to a media file in post content, as in
+	// ![A diagram](media:3f2a9c1b7e4d)
+	Scheme = "media:"
+
+	// RefLength is the number of hash characters in the references cms hands
+	// out. Shorter prefixes are accepted as long as they are unambiguous
+	RefLength = 12
+
+	// MinRefLength is the shortest hash prefix a reference can use
+	MinRefLength = 6
+)
+
+// refPattern matches a reference and captures its hash prefix
+var refPattern = regexp.MustCompile(`\bmedia:([0-9a-f]{6,64})\b`)
+
+// File describes a file added to a Store
+type File struct {
+	Hash     string
+	Filename string
+	MimeType string
+	Size     int64
+	// Width and Height are zero for anything but PNG, JPEG and GIF images
+	Width  int
+	Height int
+}
+
+// Store keeps files in a directory by the SHA-256 hash of their content, so
+// each file is only stored once however many times it is added. A file with
+// hash abcd... is stored at <dir>/ab/abcd...
+type Store struct {
+	dir string
+}
+
+// NewStore returns a Store that keeps its files in dir
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Dir returns the directory the store keeps its files in
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Path returns where the file with a hash is stored
+func (s *Store) Path(hash string) string {
+	return filepath.Join(s.dir, hash[:2], hash)
+}
+
+// Add copies the file at src into the store and describes it. Adding a file
+// that is already stored leaves the stored copy as it is
+func (s *Store) Add(src string) (*File, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, err
+	}
+
+	// Copy into a temporary file first, as the hash isn't known until the
+	// whole file has been read
+	tmp, err := os.CreateTemp(s.dir, ".add-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	hasher := sha256.New()
+	reader := bufio.NewReader(in)
+	head, _ := reader.Peek(512)
+	mimeType := detectType(src, head)
+
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), reader)
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	file := &File{
+		Hash:     hex.EncodeToString(hasher.Sum(nil)),
+		Filename: filepath.Base(src),
+		MimeType: mimeType,
+		Size:     size,
+	}
+
+	if strings.HasPrefix(mimeType, "image/") {
+		if f, err := os.Open(tmp.Name()); err == nil {
+			if config, _, err := image.DecodeConfig(f); err == nil {
+				file.Width, file.Height = config.Width, config.Height
+			}
+			f.Close()
+		}
+	}
+
+	dest := s.Path(file.Hash)
+	if _, err := os.Stat(dest); err == nil {
+		return file, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), dest); err != nil {
+		return nil, err
+	}
+	// CreateTemp makes files only the owner can read
+	if err := os.Chmod(dest, 0644); err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Remove deletes the file with a hash. Removing a file that isn't stored is
+// not an error
+func (s *Store) Remove(hash string) error {
+	err := os.Remove(s.Path(hash))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// Tidy up the directory once it is empty
+	os.Remove(filepath.Dir(s.Path(hash)))
+	return nil
+}
+
+// Hashes lists the hashes of every stored file. A store that doesn't exist
+// yet is empty
+func (s *Store) Hashes() ([]string, error) {
+	var hashes []string
+	err := filepath.WalkDir(s.dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if p == s.dir && errors.Is(err, fs.ErrNotExist) {
+				return fs.SkipAll
+			}
+			return err
+		}
+
+		name := entry.Name()
+		if !entry.IsDir() && isHash(name) && filepath.Base(filepath.Dir(p)) == name[:2] {
+			hashes = append(hashes, name)
+		}
+		return nil
+	})
+
+	return hashes, err
+}
+
+// Ref returns the reference to use for the file with a hash in post content
+func Ref(hash string) string {
+	return Scheme + hash[:min(RefLength, len(hash))]
+}
+
+// ParseRef returns the hash prefix in a reference. The media: scheme is
+// optional, so a bare hash prefix is accepted too
+func ParseRef(ref string) (string, error) {
+	prefix := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ref), Scheme))
+	if len(prefix) < MinRefLength || len(prefix) > sha256.Size*2 {
+		return "", fmt.Errorf("invalid media reference %q: use at least %d characters of the hash", ref, MinRefLength)
+	}
+	if strings.Trim(prefix, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("invalid media reference %q: not a hash", ref)
+	}
+	return prefix, nil
+}
+
+// References returns the hash prefix of every media reference in content
+func References(content string) []string {
+	var prefixes []string
+	for _, match := range refPattern.FindAllStringSubmatch(content, -1) {
+		prefixes = append(prefixes, match[1])
+	}
+	return prefixes
+}
+
+// ReplaceReferences replaces every media reference in content with what
+// replace returns for its hash prefix
+func ReplaceReferences(content string, replace func(prefix string) string) string {
+	return refPattern.ReplaceAllStringFunc(content, func(ref string) string {
+		return replace(strings.TrimPrefix(ref, Scheme))
+	})
+}
+
+// Referenced reports whether any of the hash prefixes refers to hash
+func Referenced(hash string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ext returns the file extension a file is published with, taken from its
+// name or else its type
+func Ext(filename, mimeType string) string {
+	if ext := strings.ToLower(filepath.Ext(filename)); ext != "" {
+		return ext
+	}
+	if exts, err := mime.ExtensionsByType(mimeType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
+
+// FormatSize formats a number of bytes for people to read
+func FormatSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// detectType works out a file's MIME type from its content, falling back to
+// its extension for types that can't be sniffed, such as SVG
+func detectType(name string, head []byte) string {
+	sniffed := http.DetectContentType(head)
+	generic := sniffed == "application/octet-stream" ||
+		strings.HasPrefix(sniffed, "text/plain") ||
+		strings.HasPrefix(sniffed, "text/xml")
+	if !generic {
+		return sniffed
+	}
+
+	if byExt := mime.TypeByExtension(strings.ToLower(filepath.Ext(name))); byExt != "" {
+		return byExt
+	}
+	return sniffed
+}
+
+func isHash(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
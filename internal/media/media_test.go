@@ -0,0 +1,112 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePNG(t *testing.T, path string, width, height int) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+}
+
+func TestStoreAdd(t *testing.T) {
+	src := t.TempDir()
+	store := NewStore(filepath.Join(t.TempDir(), "media"))
+
+	writePNG(t, filepath.Join(src, "Diagram.PNG"), 40, 30)
+
+	file, err := store.Add(filepath.Join(src, "Diagram.PNG"))
+	require.NoError(t, err)
+
+	assert.Len(t, file.Hash, 64)
+	assert.Equal(t, "Diagram.PNG", file.Filename)
+	assert.Equal(t, "image/png", file.MimeType)
+	assert.Equal(t, 40, file.Width)
+	assert.Equal(t, 30, file.Height)
+	assert.FileExists(t, filepath.Join(store.Dir(), file.Hash[:2], file.Hash))
+
+	t.Run("same content is stored once", func(t *testing.T) {
+		data, err := os.ReadFile(filepath.Join(src, "Diagram.PNG"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(src, "copy.png"), data, 0644))
+
+		again, err := store.Add(filepath.Join(src, "copy.png"))
+		require.NoError(t, err)
+		assert.Equal(t, file.Hash, again.Hash)
+
+		hashes, err := store.Hashes()
+		require.NoError(t, err)
+		assert.Equal(t, []string{file.Hash}, hashes, "no temporary files are left behind")
+	})
+
+	t.Run("types that can't be sniffed use the extension", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(src, "logo.svg"), []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`), 0644))
+
+		svg, err := store.Add(filepath.Join(src, "logo.svg"))
+		require.NoError(t, err)
+		assert.Equal(t, "image/svg+xml", svg.MimeType)
+		assert.Zero(t, svg.Width)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		require.NoError(t, store.Remove(file.Hash))
+		assert.NoFileExists(t, store.Path(file.Hash))
+		assert.NoError(t, store.Remove(file.Hash), "removing twice is fine")
+	})
+}
+
+func TestStoreHashesMissingDir(t *testing.T) {
+	hashes, err := NewStore(filepath.Join(t.TempDir(), "missing")).Hashes()
+	require.NoError(t, err)
+	assert.Empty(t, hashes)
+}
+
+func TestParseRef(t *testing.T) {
+	prefix, err := ParseRef("media:3F2A9C1B7E4D")
+	require.NoError(t, err)
+	assert.Equal(t, "3f2a9c1b7e4d", prefix)
+
+	prefix, err = ParseRef("3f2a9c")
+	require.NoError(t, err)
+	assert.Equal(t, "3f2a9c", prefix)
+
+	_, err = ParseRef("media:3f2a")
+	assert.Error(t, err, "too short")
+
+	_, err = ParseRef("media:photo.png")
+	assert.Error(t, err, "not a hash")
+}
+
+func TestReferences(t *testing.T) {
+	content := "![Diagram](media:3f2a9c1b7e4d)\n\nSee [the PDF](media:abcdef012345) and media:abcdef012345 again.\n\nNot social media:coverage or media:12ab."
+
+	assert.Equal(t, []string{"3f2a9c1b7e4d", "abcdef012345", "abcdef012345"}, References(content))
+
+	replaced := ReplaceReferences(content, func(prefix string) string {
+		return "/media/" + prefix
+	})
+	assert.Contains(t, replaced, "![Diagram](/media/3f2a9c1b7e4d)")
+	assert.Contains(t, replaced, "media:coverage")
+
+	assert.True(t, Referenced("abcdef0123456789", References(content)))
+	assert.False(t, Referenced("0123456789abcdef", References(content)))
+}
+
+func TestRef(t *testing.T) {
+	assert.Equal(t, "media:0123456789ab", Ref("0123456789abcdef0123456789abcdef"))
+}
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "512 B", FormatSize(512))
+	assert.Equal(t, "1.5 KiB", FormatSize(1536))
+	assert.Equal(t, "2.0 MiB", FormatSize(2*1024*1024))
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format is the way command results are written
+type Format string
+
+const (
+	FormatTable    Format = "table"
+	FormatJSON     Format = "json"
+	FormatNDJSON   Format = "ndjson"
+	FormatYAML     Format = "yaml"
+	FormatCSV      Format = "csv"
+	FormatTemplate Format = "template"
+)
+
+// Formats lists every supported output format
+var Formats = []Format{
+	FormatTable,
+	FormatJSON,
+	FormatNDJSON,
+	FormatYAML,
+	FormatCSV,
+	FormatTemplate,
+}
+
+// ParseFormat parses an --output value. Templates are given inline as
+// template=<go template>, e.g. template={{.Title}}
+func ParseFormat(value string) (Format, string, error) {
+	if value == "" {
+		return FormatTable, "", nil
+	}
+
+	name, tmpl, hasTemplate := strings.Cut(value, "=")
+	format := Format(name)
+
+	if format == FormatTemplate {
+		if !hasTemplate || tmpl == "" {
+			return "", "", fmt.Errorf("template output needs a template, e.g. --output 'template={{.Title}}'")
+		}
+		return format, tmpl, nil
+	}
+
+	for _, f := range Formats {
+		if f == format && !hasTemplate {
+			return format, "", nil
+		}
+	}
+
+	return "", "", fmt.Errorf("invalid output format %q (must be one of table, json, ndjson, yaml, csv, template=...)", value)
+}
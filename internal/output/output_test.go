@@ -0,0 +1,145 @@
+package output
+
+import (
+	"bytes"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		value        string
+		wantFormat   Format
+		wantTemplate string
+		wantErr      bool
+	}{
+		{name: "empty defaults to table", value: "", wantFormat: FormatTable},
+		{name: "json", value: "json", wantFormat: FormatJSON},
+		{name: "csv", value: "csv", wantFormat: FormatCSV},
+		{name: "template", value: "template={{.Title}}", wantFormat: FormatTemplate, wantTemplate: "{{.Title}}"},
+		{name: "template without body", value: "template", wantErr: true},
+		{name: "value on non-template format", value: "json=x", wantErr: true},
+		{name: "unknown", value: "xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, tmpl, err := ParseFormat(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFormat, format)
+			assert.Equal(t, tt.wantTemplate, tmpl)
+		})
+	}
+}
+
+func testPosts() []Post {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return []Post{
+		{ID: 1, Title: "First", Author: "Jane", Slug: "first", Status: "draft", Tags: []string{"go", "cli"}, CreatedAt: &created},
+		{ID: 2, Title: "Second, with comma", Status: "published"},
+	}
+}
+
+func TestPrinterFormats(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{
+			format: "ndjson",
+			want: `{"id":1,"title":"First","content":"","author":"Jane","slug":"first","status":"draft","tags":["go","cli"],"created_at":"2025-01-02T03:04:05Z"}
+{"id":2,"title":"Second, with comma","content":"","author":"","slug":"","status":"published","tags":null}
+`,
+		},
+		{
+			format: "csv",
+			want: `id,title,content,author,slug,status,tags,published_at,scheduled_for,created_at,updated_at
+1,First,,Jane,first,draft,go;cli,,,2025-01-02T03:04:05Z,
+2,"Second, with comma",,,,published,,,,,
+`,
+		},
+		{
+			format: "template={{.ID}}: {{.Title}}",
+			want:   "1: First\n2: Second, with comma\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			printer, err := New(&buf, tt.format)
+			require.NoError(t, err)
+
+			require.NoError(t, printer.PrintList(testPosts()))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestPrinterJSONAndYAML(t *testing.T) {
+	var buf bytes.Buffer
+	printer, err := New(&buf, "json")
+	require.NoError(t, err)
+
+	require.NoError(t, printer.PrintList([]Post{}))
+	assert.Equal(t, "[]\n", buf.String(), "Empty lists should still be valid JSON")
+
+	buf.Reset()
+	require.NoError(t, printer.Print(testPosts()[0]))
+	assert.Contains(t, buf.String(), "\n  \"title\": \"First\",\n")
+
+	buf.Reset()
+	printer, err = New(&buf, "yaml")
+	require.NoError(t, err)
+	require.NoError(t, printer.Print(SearchResult{Post: testPosts()[0], Rank: 1.5}))
+	assert.Contains(t, buf.String(), "title: First\n")
+	assert.Contains(t, buf.String(), "rank: 1.5\n")
+}
+
+func TestPrinterCSVHeader(t *testing.T) {
+	var buf bytes.Buffer
+	printer, err := New(&buf, "csv")
+	require.NoError(t, err)
+
+	require.NoError(t, printer.PrintList([]Post{}))
+	assert.Equal(t, "id,title,content,author,slug,status,tags,published_at,scheduled_for,created_at,updated_at\n", buf.String(),
+		"Empty lists should still have a header")
+
+	buf.Reset()
+	require.NoError(t, printer.PrintList([]*SearchResult{}))
+	assert.True(t, strings.HasPrefix(buf.String(), "id,title,"), buf.String())
+	assert.Contains(t, buf.String(), ",rank")
+}
+
+func TestPrinterInvalidTemplate(t *testing.T) {
+	_, err := New(&bytes.Buffer{}, "template={{.Title")
+	assert.Error(t, err)
+}
+
+func TestNewPost(t *testing.T) {
+	post := NewPost(&database.Post{
+		ID:        7,
+		Title:     "Hello",
+		Content:   sql.NullString{String: "Body", Valid: true},
+		Status:    "published",
+		CreatedAt: sql.NullTime{Time: time.Now(), Valid: true},
+	}, []database.Tag{{Name: "go"}})
+
+	assert.Equal(t, int64(7), post.ID)
+	assert.Equal(t, "Body", post.Content)
+	assert.Empty(t, post.Author)
+	assert.Equal(t, []string{"go"}, post.Tags)
+	assert.NotNil(t, post.CreatedAt)
+	assert.Nil(t, post.PublishedAt)
+}
@@ -0,0 +1,133 @@
+package output
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+)
+
+// Post is the machine-readable representation of a post
+type Post struct {
+	ID           int64      `json:"id" yaml:"id"`
+	Title        string     `json:"title" yaml:"title"`
+	Content      string     `json:"content" yaml:"content"`
+	Author       string     `json:"author" yaml:"author"`
+	Slug         string     `json:"slug" yaml:"slug"`
+	Status       string     `json:"status" yaml:"status"`
+	Tags         []string   `json:"tags" yaml:"tags"`
+	PublishedAt  *time.Time `json:"published_at,omitempty" yaml:"published_at,omitempty"`
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty" yaml:"scheduled_for,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+}
+
+// SearchResult is the machine-readable representation of a search match
+type SearchResult struct {
+	Post    `yaml:",inline"`
+	Rank    float64 `json:"rank" yaml:"rank"`
+	Snippet string  `json:"snippet" yaml:"snippet"`
+}
+
+// NewPost converts a database post and its tags into a Post
+func NewPost(post *database.Post, tags []database.Tag) Post {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	return Post{
+		ID:           post.ID,
+		Title:        post.Title,
+		Content:      database.NullStringToString(post.Content),
+		Author:       database.NullStringToString(post.Author),
+		Slug:         database.NullStringToString(post.Slug),
+		Status:       post.Status,
+		Tags:         names,
+		PublishedAt:  timePointer(post.PublishedAt),
+		ScheduledFor: timePointer(post.ScheduledFor),
+		CreatedAt:    timePointer(post.CreatedAt),
+		UpdatedAt:    timePointer(post.UpdatedAt),
+	}
+}
+
+func timePointer(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
+
+// Redirect is the machine-readable representation of a redirect from an old slug
+type Redirect struct {
+	From      string     `json:"from" yaml:"from"`
+	To        string     `json:"to" yaml:"to"`
+	PostID    int64      `json:"post_id" yaml:"post_id"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// NewRedirect converts a database redirect into a Redirect
+func NewRedirect(redirect database.Redirect) Redirect {
+	return Redirect{
+		From:      redirect.FromSlug,
+		To:        database.NullStringToString(redirect.ToSlug),
+		PostID:    redirect.PostID,
+		CreatedAt: timePointer(redirect.CreatedAt),
+	}
+}
+
+// Author is the machine-readable representation of an author
+type Author struct {
+	Name      string     `json:"name" yaml:"name"`
+	Email     string     `json:"email,omitempty" yaml:"email,omitempty"`
+	Bio       string     `json:"bio,omitempty" yaml:"bio,omitempty"`
+	AvatarURL string     `json:"avatar_url,omitempty" yaml:"avatar_url,omitempty"`
+	Posts     int64      `json:"posts" yaml:"posts"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// NewAuthor converts a database author and their post count into an Author
+func NewAuthor(author database.AuthorWithCount) Author {
+	return Author{
+		Name:      author.Name,
+		Email:     database.NullStringToString(author.Email),
+		Bio:       database.NullStringToString(author.Bio),
+		AvatarURL: database.NullStringToString(author.AvatarUrl),
+		Posts:     author.PostCount,
+		CreatedAt: timePointer(author.CreatedAt),
+	}
+}
+
+// Media is the machine-readable representation of a file in the media library
+type Media struct {
+	Ref       string     `json:"ref" yaml:"ref"`
+	Hash      string     `json:"hash" yaml:"hash"`
+	Filename  string     `json:"filename" yaml:"filename"`
+	MimeType  string     `json:"mime_type" yaml:"mime_type"`
+	Size      int64      `json:"size" yaml:"size"`
+	Width     int64      `json:"width,omitempty" yaml:"width,omitempty"`
+	Height    int64      `json:"height,omitempty" yaml:"height,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+}
+
+// NewMedia converts a database media record into Media
+func NewMedia(m database.Media) Media {
+	return Media{
+		Ref:       media.Ref(m.Hash),
+		Hash:      m.Hash,
+		Filename:  m.Filename,
+		MimeType:  m.MimeType,
+		Size:      m.Size,
+		Width:     m.Width.Int64,
+		Height:    m.Height.Int64,
+		CreatedAt: timePointer(m.CreatedAt),
+	}
+}
+
+// Setting is the machine-readable representation of a config setting
+type Setting struct {
+	Key    string `json:"key" yaml:"key"`
+	Value  string `json:"value" yaml:"value"`
+	Source string `json:"source" yaml:"source"`
+}
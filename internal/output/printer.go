@@ -0,0 +1,235 @@
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Printer writes command results in a machine-readable format
+type Printer struct {
+	w        io.Writer
+	format   Format
+	template *template.Template
+}
+
+// New creates a Printer for an --output value
+func New(w io.Writer, value string) (*Printer, error) {
+	format, tmpl, err := ParseFormat(value)
+	if err != nil {
+		return nil, err
+	}
+
+	printer := &Printer{
+		w:      w,
+		format: format,
+	}
+
+	if format == FormatTemplate {
+		printer.template, err = template.New("output").Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid output template: %w", err)
+		}
+	}
+
+	return printer, nil
+}
+
+// Format returns the format the Printer writes
+func (p *Printer) Format() Format {
+	return p.format
+}
+
+// IsTable reports whether results should be shown as the human-readable table
+// output instead of being written by the Printer
+func (p *Printer) IsTable() bool {
+	return p.format == FormatTable
+}
+
+// Print writes a single result
+func (p *Printer) Print(item any) error {
+	switch p.format {
+	case FormatJSON:
+		return p.writeJSON(item, "  ")
+	case FormatNDJSON:
+		return p.writeJSON(item, "")
+	case FormatYAML:
+		return p.writeYAML(item)
+	case FormatCSV:
+		return p.writeCSV([]any{item}, reflect.TypeOf(item))
+	case FormatTemplate:
+		return p.writeTemplate(item)
+	}
+	return fmt.Errorf("format %q cannot be printed", p.format)
+}
+
+// PrintList writes a slice of results
+func (p *Printer) PrintList(items any) error {
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice {
+		return fmt.Errorf("PrintList expects a slice, got %T", items)
+	}
+
+	list := make([]any, value.Len())
+	for i := range list {
+		list[i] = value.Index(i).Interface()
+	}
+
+	switch p.format {
+	case FormatJSON:
+		return p.writeJSON(list, "  ")
+	case FormatYAML:
+		return p.writeYAML(list)
+	case FormatCSV:
+		return p.writeCSV(list, value.Type().Elem())
+	}
+
+	// ndjson and templates write one line per item
+	for _, item := range list {
+		if err := p.Print(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Printer) writeJSON(v any, indent string) error {
+	encoder := json.NewEncoder(p.w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+	return encoder.Encode(v)
+}
+
+func (p *Printer) writeYAML(v any) error {
+	encoder := yaml.NewEncoder(p.w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+func (p *Printer) writeTemplate(v any) error {
+	if err := p.template.Execute(p.w, v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(p.w, "\n")
+	return err
+}
+
+// writeCSV writes a header row taken from the json tags of the first item's
+// fields, followed by one row per item. Without any items the header is taken
+// from itemType, so that it is still written
+func (p *Printer) writeCSV(items []any, itemType reflect.Type) error {
+	w := csv.NewWriter(p.w)
+
+	if len(items) == 0 {
+		for itemType.Kind() == reflect.Pointer {
+			itemType = itemType.Elem()
+		}
+		header, _ := csvFields(reflect.Zero(itemType))
+		if err := w.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for i, item := range items {
+		header, row := csvFields(reflect.ValueOf(item))
+		if i == 0 {
+			if err := w.Write(header); err != nil {
+				return err
+			}
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// csvFields flattens a struct into column names and values. Embedded structs
+// are inlined and fields tagged json:"-" are skipped
+func csvFields(v reflect.Value) ([]string, []string) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return []string{"value"}, []string{csvValue(v)}
+	}
+
+	var header, row []string
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if field.Anonymous && name == "" {
+			h, r := csvFields(v.Field(i))
+			header = append(header, h...)
+			row = append(row, r...)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		header = append(header, name)
+		row = append(row, csvValue(v.Field(i)))
+	}
+
+	return header, row
+}
+
+func csvValue(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = csvValue(v.Index(i))
+		}
+		return strings.Join(values, ";")
+	}
+
+	return fmt.Sprint(v.Interface())
+}
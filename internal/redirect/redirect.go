@@ -0,0 +1,101 @@
+package redirect
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/site"
+)
+
+// Format is a web server or hosting provider's redirect configuration format
+type Format string
+
+const (
+	FormatNginx   Format = "nginx"
+	FormatNetlify Format = "netlify"
+	FormatApache  Format = "apache"
+)
+
+// Formats lists every supported redirect format
+var Formats = []Format{FormatNginx, FormatNetlify, FormatApache}
+
+// ParseFormat validates and converts a string into a Format
+func ParseFormat(s string) (Format, error) {
+	for _, format := range Formats {
+		if string(format) == s {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("invalid redirect format %q (must be one of nginx, netlify, apache)", s)
+}
+
+// Rule is a permanent redirect between two paths relative to the site root,
+// matching the post pages written by "cms build"
+type Rule struct {
+	From string
+	To   string
+}
+
+// Rules converts redirects into rules between post page paths
+func Rules(redirects []database.Redirect) []Rule {
+	rules := make([]Rule, 0, len(redirects))
+	for _, redirect := range redirects {
+		if !redirect.ToSlug.Valid {
+			continue
+		}
+		rules = append(rules, Rule{
+			From: "/" + site.PostURL(redirect.FromSlug),
+			To:   "/" + site.PostURL(redirect.ToSlug.String),
+		})
+	}
+	return rules
+}
+
+// Write writes rules as redirect configuration in the given format:
+//   - nginx: rewrite directives to include in a server block
+//   - netlify: a _redirects file
+//   - apache: RedirectMatch directives for a .htaccess file or vhost
+//
+// The nginx and apache rules also match the path without its trailing slash
+func Write(w io.Writer, format Format, rules []Rule) error {
+	var line func(Rule) string
+	switch format {
+	case FormatNginx:
+		line = func(rule Rule) string {
+			return fmt.Sprintf("rewrite %s %s permanent;", pattern(rule.From), escape(rule.To))
+		}
+	case FormatNetlify:
+		line = func(rule Rule) string {
+			return fmt.Sprintf("%s %s 301", escape(rule.From), escape(rule.To))
+		}
+	case FormatApache:
+		line = func(rule Rule) string {
+			return fmt.Sprintf("RedirectMatch 301 %s %s", pattern(rule.From), escape(rule.To))
+		}
+	default:
+		return fmt.Errorf("invalid redirect format %q", format)
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "# Redirects from old post slugs, generated by cms redirects export")
+	for _, rule := range rules {
+		fmt.Fprintln(bw, line(rule))
+	}
+	return bw.Flush()
+}
+
+// pattern is a regular expression matching a path with or without its
+// trailing slash. nginx and Apache match against the decoded path
+func pattern(p string) string {
+	return "^" + regexp.QuoteMeta(strings.TrimSuffix(p, "/")) + "/?$"
+}
+
+// escape percent-encodes a path for use in a Location header
+func escape(p string) string {
+	return (&url.URL{Path: p}).EscapedPath()
+}
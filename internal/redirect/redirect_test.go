@@ -0,0 +1,81 @@
+package redirect
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, format := range Formats {
+		parsed, err := ParseFormat(string(format))
+		require.NoError(t, err)
+		assert.Equal(t, format, parsed)
+	}
+
+	_, err := ParseFormat("caddy")
+	assert.Error(t, err)
+}
+
+func TestRules(t *testing.T) {
+	rules := Rules([]database.Redirect{
+		{FromSlug: "old-post", ToSlug: sql.NullString{String: "new-post", Valid: true}},
+		{FromSlug: "no-target"},
+	})
+
+	assert.Equal(t, []Rule{{From: "/posts/old-post/", To: "/posts/new-post/"}}, rules)
+}
+
+func TestWrite(t *testing.T) {
+	rules := []Rule{
+		{From: "/posts/old-post/", To: "/posts/new-post/"},
+		{From: "/posts/héllo/", To: "/posts/你好/"},
+	}
+
+	tests := []struct {
+		format   Format
+		expected []string
+	}{
+		{
+			format: FormatNginx,
+			expected: []string{
+				"rewrite ^/posts/old-post/?$ /posts/new-post/ permanent;",
+				"rewrite ^/posts/héllo/?$ /posts/%E4%BD%A0%E5%A5%BD/ permanent;",
+			},
+		},
+		{
+			format: FormatNetlify,
+			expected: []string{
+				"/posts/old-post/ /posts/new-post/ 301",
+				"/posts/h%C3%A9llo/ /posts/%E4%BD%A0%E5%A5%BD/ 301",
+			},
+		},
+		{
+			format: FormatApache,
+			expected: []string{
+				"RedirectMatch 301 ^/posts/old-post/?$ /posts/new-post/",
+				"RedirectMatch 301 ^/posts/héllo/?$ /posts/%E4%BD%A0%E5%A5%BD/",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, tt.format, rules))
+
+			lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+			require.Len(t, lines, len(tt.expected)+1)
+			assert.True(t, bytes.HasPrefix(lines[0], []byte("#")), "starts with a comment")
+			for i, line := range tt.expected {
+				assert.Equal(t, line, string(lines[i+1]))
+			}
+		})
+	}
+
+	assert.Error(t, Write(&bytes.Buffer{}, Format("caddy"), rules))
+}
@@ -8,12 +8,67 @@ import (
 	"database/sql"
 )
 
+type Author struct {
+	ID        int64
+	Name      string
+	Email     sql.NullString
+	Bio       sql.NullString
+	AvatarUrl sql.NullString
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+type Medium struct {
+	ID        int64
+	Hash      string
+	Filename  string
+	MimeType  string
+	Size      int64
+	Width     sql.NullInt64
+	Height    sql.NullInt64
+	CreatedAt sql.NullTime
+}
+
 type Post struct {
+	ID           int64
+	Title        string
+	Content      sql.NullString
+	Author       sql.NullString
+	Slug         sql.NullString
+	CreatedAt    sql.NullTime
+	UpdatedAt    sql.NullTime
+	Status       string
+	PublishedAt  sql.NullTime
+	ScheduledFor sql.NullTime
+	DeletedAt    sql.NullTime
+	AuthorID     sql.NullInt64
+}
+
+type PostRevision struct {
 	ID        int64
+	PostID    int64
+	Revision  int64
 	Title     string
 	Content   sql.NullString
 	Author    sql.NullString
 	Slug      sql.NullString
 	CreatedAt sql.NullTime
-	UpdatedAt sql.NullTime
+}
+
+type PostTag struct {
+	PostID int64
+	TagID  int64
+}
+
+type Redirect struct {
+	ID        int64
+	FromSlug  string
+	PostID    int64
+	CreatedAt sql.NullTime
+}
+
+type Tag struct {
+	ID        int64
+	Name      string
+	CreatedAt sql.NullTime
 }
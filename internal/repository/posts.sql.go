@@ -10,19 +10,34 @@ import (
 	"database/sql"
 )
 
+const countPostsWithSlug = `-- name: CountPostsWithSlug :one
+SELECT COUNT(*) FROM posts WHERE slug = ?
+`
+
+func (q *Queries) CountPostsWithSlug(ctx context.Context, slug sql.NullString) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPostsWithSlug, slug)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createPost = `-- name: CreatePost :one
-INSERT INTO posts (title, content, author, slug, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?)
-RETURNING id, title, content, author, slug, created_at, updated_at
+INSERT INTO posts (title, content, author, author_id, slug, status, published_at, scheduled_for, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+RETURNING id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id
 `
 
 type CreatePostParams struct {
-	Title     string
-	Content   sql.NullString
-	Author    sql.NullString
-	Slug      sql.NullString
-	CreatedAt sql.NullTime
-	UpdatedAt sql.NullTime
+	Title        string
+	Content      sql.NullString
+	Author       sql.NullString
+	AuthorID     sql.NullInt64
+	Slug         sql.NullString
+	Status       string
+	PublishedAt  sql.NullTime
+	ScheduledFor sql.NullTime
+	CreatedAt    sql.NullTime
+	UpdatedAt    sql.NullTime
 }
 
 func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, error) {
@@ -30,7 +45,11 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, e
 		arg.Title,
 		arg.Content,
 		arg.Author,
+		arg.AuthorID,
 		arg.Slug,
+		arg.Status,
+		arg.PublishedAt,
+		arg.ScheduledFor,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -43,30 +62,51 @@ func (q *Queries) CreatePost(ctx context.Context, arg CreatePostParams) (Post, e
 		&i.Slug,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
 	)
 	return i, err
 }
 
-const deletePostByID = `-- name: DeletePostByID :exec
-DELETE FROM posts WHERE id = ?
+const deletePostByID = `-- name: DeletePostByID :execrows
+UPDATE posts SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL
 `
 
-func (q *Queries) DeletePostByID(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deletePostByID, id)
-	return err
+type DeletePostByIDParams struct {
+	DeletedAt sql.NullTime
+	ID        int64
+}
+
+func (q *Queries) DeletePostByID(ctx context.Context, arg DeletePostByIDParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePostByID, arg.DeletedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-const deletePostBySlug = `-- name: DeletePostBySlug :exec
-DELETE FROM posts WHERE slug = ?
+const deletePostBySlug = `-- name: DeletePostBySlug :execrows
+UPDATE posts SET deleted_at = ? WHERE slug = ? AND deleted_at IS NULL
 `
 
-func (q *Queries) DeletePostBySlug(ctx context.Context, slug sql.NullString) error {
-	_, err := q.db.ExecContext(ctx, deletePostBySlug, slug)
-	return err
+type DeletePostBySlugParams struct {
+	DeletedAt sql.NullTime
+	Slug      sql.NullString
+}
+
+func (q *Queries) DeletePostBySlug(ctx context.Context, arg DeletePostBySlugParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deletePostBySlug, arg.DeletedAt, arg.Slug)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
 const getPostByID = `-- name: GetPostByID :one
-SELECT id, title, content, author, slug, created_at, updated_at FROM posts WHERE id = ?
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts WHERE id = ? AND deleted_at IS NULL
 `
 
 func (q *Queries) GetPostByID(ctx context.Context, id int64) (Post, error) {
@@ -80,12 +120,17 @@ func (q *Queries) GetPostByID(ctx context.Context, id int64) (Post, error) {
 		&i.Slug,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
 	)
 	return i, err
 }
 
 const getPostBySlug = `-- name: GetPostBySlug :one
-SELECT id, title, content, author, slug, created_at, updated_at FROM posts WHERE slug = ?
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts WHERE slug = ? AND deleted_at IS NULL
 `
 
 func (q *Queries) GetPostBySlug(ctx context.Context, slug sql.NullString) (Post, error) {
@@ -99,12 +144,17 @@ func (q *Queries) GetPostBySlug(ctx context.Context, slug sql.NullString) (Post,
 		&i.Slug,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
 	)
 	return i, err
 }
 
 const listPosts = `-- name: ListPosts :many
-SELECT id, title, content, author, slug, created_at, updated_at FROM posts ORDER BY id ASC
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC
 `
 
 func (q *Queries) ListPosts(ctx context.Context) ([]Post, error) {
@@ -124,6 +174,104 @@ func (q *Queries) ListPosts(ctx context.Context) ([]Post, error) {
 			&i.Slug,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ScheduledFor,
+			&i.DeletedAt,
+			&i.AuthorID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByStatus = `-- name: ListPostsByStatus :many
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts WHERE status = ? AND deleted_at IS NULL ORDER BY created_at DESC, id DESC
+`
+
+func (q *Queries) ListPostsByStatus(ctx context.Context, status string) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, listPostsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Content,
+			&i.Author,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ScheduledFor,
+			&i.DeletedAt,
+			&i.AuthorID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPostsByStatusWithPagination = `-- name: ListPostsByStatusWithPagination :many
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts 
+WHERE status = ? AND deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListPostsByStatusWithPaginationParams struct {
+	Status string
+	Limit  int64
+	Offset int64
+}
+
+func (q *Queries) ListPostsByStatusWithPagination(ctx context.Context, arg ListPostsByStatusWithPaginationParams) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, listPostsByStatusWithPagination,
+		arg.Status,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Content,
+			&i.Author,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ScheduledFor,
+			&i.DeletedAt,
+			&i.AuthorID,
 		); err != nil {
 			return nil, err
 		}
@@ -139,8 +287,9 @@ func (q *Queries) ListPosts(ctx context.Context) ([]Post, error) {
 }
 
 const listPostsWithPagination = `-- name: ListPostsWithPagination :many
-SELECT id, title, content, author, slug, created_at, updated_at FROM posts 
-ORDER BY created_at DESC 
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts 
+WHERE deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
 LIMIT ? OFFSET ?
 `
 
@@ -166,6 +315,11 @@ func (q *Queries) ListPostsWithPagination(ctx context.Context, arg ListPostsWith
 			&i.Slug,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ScheduledFor,
+			&i.DeletedAt,
+			&i.AuthorID,
 		); err != nil {
 			return nil, err
 		}
@@ -180,17 +334,164 @@ func (q *Queries) ListPostsWithPagination(ctx context.Context, arg ListPostsWith
 	return items, nil
 }
 
+const listTrashedPosts = `-- name: ListTrashedPosts :many
+SELECT id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id FROM posts WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC
+`
+
+func (q *Queries) ListTrashedPosts(ctx context.Context) ([]Post, error) {
+	rows, err := q.db.QueryContext(ctx, listTrashedPosts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Post
+	for rows.Next() {
+		var i Post
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Content,
+			&i.Author,
+			&i.Slug,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ScheduledFor,
+			&i.DeletedAt,
+			&i.AuthorID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const publishDuePosts = `-- name: PublishDuePosts :execrows
+UPDATE posts 
+SET status = 'published', published_at = scheduled_for, updated_at = ?
+WHERE status = 'scheduled' AND scheduled_for <= ? AND deleted_at IS NULL
+`
+
+type PublishDuePostsParams struct {
+	UpdatedAt    sql.NullTime
+	ScheduledFor sql.NullTime
+}
+
+func (q *Queries) PublishDuePosts(ctx context.Context, arg PublishDuePostsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, publishDuePosts, arg.UpdatedAt, arg.ScheduledFor)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const purgePostByID = `-- name: PurgePostByID :execrows
+DELETE FROM posts WHERE id = ? AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) PurgePostByID(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgePostByID, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const purgePostBySlug = `-- name: PurgePostBySlug :execrows
+DELETE FROM posts WHERE slug = ? AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) PurgePostBySlug(ctx context.Context, slug sql.NullString) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgePostBySlug, slug)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const purgeTrashedPosts = `-- name: PurgeTrashedPosts :execrows
+DELETE FROM posts WHERE deleted_at IS NOT NULL AND deleted_at <= ?
+`
+
+func (q *Queries) PurgeTrashedPosts(ctx context.Context, deletedAt sql.NullTime) (int64, error) {
+	result, err := q.db.ExecContext(ctx, purgeTrashedPosts, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restorePostByID = `-- name: RestorePostByID :one
+UPDATE posts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL
+RETURNING id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id
+`
+
+func (q *Queries) RestorePostByID(ctx context.Context, id int64) (Post, error) {
+	row := q.db.QueryRowContext(ctx, restorePostByID, id)
+	var i Post
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Content,
+		&i.Author,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
+	)
+	return i, err
+}
+
+const restorePostBySlug = `-- name: RestorePostBySlug :one
+UPDATE posts SET deleted_at = NULL WHERE slug = ? AND deleted_at IS NOT NULL
+RETURNING id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id
+`
+
+func (q *Queries) RestorePostBySlug(ctx context.Context, slug sql.NullString) (Post, error) {
+	row := q.db.QueryRowContext(ctx, restorePostBySlug, slug)
+	var i Post
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Content,
+		&i.Author,
+		&i.Slug,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
+	)
+	return i, err
+}
+
 const updatePostByID = `-- name: UpdatePostByID :one
 UPDATE posts 
-SET title = ?, content = ?, author = ?, updated_at = ?
-WHERE id = ?
-RETURNING id, title, content, author, slug, created_at, updated_at
+SET title = ?, content = ?, author = ?, author_id = ?, slug = ?, updated_at = ?
+WHERE id = ? AND deleted_at IS NULL
+RETURNING id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id
 `
 
 type UpdatePostByIDParams struct {
 	Title     string
 	Content   sql.NullString
 	Author    sql.NullString
+	AuthorID  sql.NullInt64
+	Slug      sql.NullString
 	UpdatedAt sql.NullTime
 	ID        int64
 }
@@ -200,6 +501,8 @@ func (q *Queries) UpdatePostByID(ctx context.Context, arg UpdatePostByIDParams)
 		arg.Title,
 		arg.Content,
 		arg.Author,
+		arg.AuthorID,
+		arg.Slug,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -212,32 +515,37 @@ func (q *Queries) UpdatePostByID(ctx context.Context, arg UpdatePostByIDParams)
 		&i.Slug,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
 	)
 	return i, err
 }
 
-const updatePostBySlug = `-- name: UpdatePostBySlug :one
+const updatePostStatus = `-- name: UpdatePostStatus :one
 UPDATE posts 
-SET title = ?, content = ?, author = ?, updated_at = ?
-WHERE slug = ?
-RETURNING id, title, content, author, slug, created_at, updated_at
+SET status = ?, published_at = ?, scheduled_for = ?, updated_at = ?
+WHERE id = ? AND deleted_at IS NULL
+RETURNING id, title, content, author, slug, created_at, updated_at, status, published_at, scheduled_for, deleted_at, author_id
 `
 
-type UpdatePostBySlugParams struct {
-	Title     string
-	Content   sql.NullString
-	Author    sql.NullString
-	UpdatedAt sql.NullTime
-	Slug      sql.NullString
+type UpdatePostStatusParams struct {
+	Status       string
+	PublishedAt  sql.NullTime
+	ScheduledFor sql.NullTime
+	UpdatedAt    sql.NullTime
+	ID           int64
 }
 
-func (q *Queries) UpdatePostBySlug(ctx context.Context, arg UpdatePostBySlugParams) (Post, error) {
-	row := q.db.QueryRowContext(ctx, updatePostBySlug,
-		arg.Title,
-		arg.Content,
-		arg.Author,
+func (q *Queries) UpdatePostStatus(ctx context.Context, arg UpdatePostStatusParams) (Post, error) {
+	row := q.db.QueryRowContext(ctx, updatePostStatus,
+		arg.Status,
+		arg.PublishedAt,
+		arg.ScheduledFor,
 		arg.UpdatedAt,
-		arg.Slug,
+		arg.ID,
 	)
 	var i Post
 	err := row.Scan(
@@ -248,6 +556,11 @@ func (q *Queries) UpdatePostBySlug(ctx context.Context, arg UpdatePostBySlugPara
 		&i.Slug,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ScheduledFor,
+		&i.DeletedAt,
+		&i.AuthorID,
 	)
 	return i, err
 }
@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/dreamsofcode-io/cli-cms/internal/slug"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+// listPostsResponse is the body returned when listing posts
+type listPostsResponse struct {
+	Posts  []output.Post `json:"posts"`
+	Total  int64         `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+}
+
+// createPostRequest is the body accepted when creating a post
+type createPostRequest struct {
+	Title   string   `json:"title"`
+	Content string   `json:"content"`
+	Author  string   `json:"author"`
+	Slug    string   `json:"slug"`
+	Status  string   `json:"status"`
+	Tags    []string `json:"tags"`
+}
+
+// updatePostRequest is the body accepted when updating a post. Only the fields
+// that are present are changed
+type updatePostRequest struct {
+	Title   *string   `json:"title"`
+	Content *string   `json:"content"`
+	Author  *string   `json:"author"`
+	Slug    *string   `json:"slug"`
+	Status  *string   `json:"status"`
+	Tags    *[]string `json:"tags"`
+}
+
+// validate checks a create request, returning a message describing the first problem
+func (req createPostRequest) validate() error {
+	if strings.TrimSpace(req.Title) == "" {
+		return errors.New("title is required")
+	}
+
+	if req.Slug != "" {
+		if err := slug.Validate(req.Slug); err != nil {
+			return err
+		}
+	}
+
+	return validateStatus(req.Status)
+}
+
+// validate checks an update request, returning a message describing the first problem
+func (req updatePostRequest) validate() error {
+	if req.Title == nil && req.Content == nil && req.Author == nil && req.Slug == nil && req.Status == nil && req.Tags == nil {
+		return errors.New("at least one of title, content, author, slug, status or tags must be set")
+	}
+
+	if req.Title != nil && strings.TrimSpace(*req.Title) == "" {
+		return errors.New("title cannot be empty")
+	}
+
+	if req.Slug != nil {
+		if err := slug.Validate(*req.Slug); err != nil {
+			return err
+		}
+	}
+
+	if req.Status != nil {
+		return validateStatus(*req.Status)
+	}
+
+	return nil
+}
+
+// validateStatus only allows the statuses that don't need extra information
+func validateStatus(status string) error {
+	switch database.PostStatus(status) {
+	case "", database.StatusDraft, database.StatusPublished, database.StatusArchived:
+		return nil
+	}
+	return fmt.Errorf("invalid status %q (must be one of draft, published, archived)", status)
+}
+
+func (s *Server) listPosts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit, err := queryInt(query.Get("limit"), defaultLimit)
+	if err != nil || limit < 1 || limit > maxLimit {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be a number between 1 and %d", maxLimit))
+		return
+	}
+
+	offset, err := queryInt(query.Get("offset"), 0)
+	if err != nil || offset < 0 {
+		s.writeError(w, http.StatusBadRequest, "offset must be a positive number")
+		return
+	}
+
+	filter := database.PostFilter{
+		Tag:    query.Get("tag"),
+		Limit:  limit,
+		Offset: offset,
+	}
+
+	if statusValue := query.Get("status"); statusValue != "" {
+		filter.Status, err = database.ParsePostStatus(statusValue)
+		if err != nil {
+			s.writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	posts, total, err := s.db.ListPostsFiltered(ctx, filter)
+	if err != nil {
+		s.writeDatabaseError(w, err)
+		return
+	}
+
+	response := listPostsResponse{
+		Posts:  make([]output.Post, len(posts)),
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	for i, post := range posts {
+		response.Posts[i], err = s.postResponse(ctx, post)
+		if err != nil {
+			s.writeDatabaseError(w, err)
+			return
+		}
+	}
+
+	s.writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) getPostByID(w http.ResponseWriter, r *http.Request) {
+	post, ok := s.lookupPostByID(w, r)
+	if !ok {
+		return
+	}
+	s.writePost(w, r.Context(), http.StatusOK, post)
+}
+
+func (s *Server) getPostBySlug(w http.ResponseWriter, r *http.Request) {
+	post, ok := s.lookupPostBySlug(w, r)
+	if !ok {
+		return
+	}
+	s.writePost(w, r.Context(), http.StatusOK, post)
+}
+
+func (s *Server) createPost(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req createPostRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	if err := req.validate(); err != nil {
+		s.writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	// The status is set as the post is inserted, and the tags in the same
+	// transaction, so a failure doesn't leave a half made post behind
+	input := database.CreatePostFromInput(strings.TrimSpace(req.Title), req.Content, req.Author, req.Slug)
+	input.Status = req.Status
+	if database.PostStatus(req.Status) == database.StatusPublished {
+		input.PublishedAt = database.TimeToNullTime(time.Now().UTC())
+	}
+
+	post, _, err := s.db.CreatePostWithTags(ctx, input, req.Tags)
+	if err != nil {
+		s.writeDatabaseError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/api/posts/%d", post.ID))
+	s.writePost(w, ctx, http.StatusCreated, post)
+}
+
+func (s *Server) updatePostByID(w http.ResponseWriter, r *http.Request) {
+	post, ok := s.lookupPostByID(w, r)
+	if !ok {
+		return
+	}
+	s.updatePost(w, r, post)
+}
+
+func (s *Server) updatePostBySlug(w http.ResponseWriter, r *http.Request) {
+	post, ok := s.lookupPostBySlug(w, r)
+	if !ok {
+		return
+	}
+	s.updatePost(w, r, post)
+}
+
+func (s *Server) updatePost(w http.ResponseWriter, r *http.Request, post *database.Post) {
+	ctx := r.Context()
+
+	var req updatePostRequest
+	if err := decodeJSON(r, &req); err != nil {
+		s.writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	if err := req.validate(); err != nil {
+		s.writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	// The fields, status and tags are saved in one transaction, so a failure
+	// leaves the post as it was
+	updates := *post
+	if req.Title != nil {
+		updates.Title = strings.TrimSpace(*req.Title)
+	}
+	if req.Content != nil {
+		updates.Content = database.StringToNullString(*req.Content)
+	}
+	if req.Author != nil {
+		updates.Author = database.StringToNullString(*req.Author)
+	}
+	if req.Slug != nil {
+		updates.Slug = database.StringToNullString(*req.Slug)
+	}
+	if req.Status != nil {
+		updates.Status = *req.Status
+	}
+
+	var tags []string
+	if req.Tags != nil {
+		tags = append([]string{}, *req.Tags...)
+	}
+
+	post, _, err := s.db.UpdatePostWithTags(ctx, int(post.ID), updates, tags)
+	if err != nil {
+		s.writeDatabaseError(w, err)
+		return
+	}
+
+	s.writePost(w, ctx, http.StatusOK, post)
+}
+
+func (s *Server) deletePostByID(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.pathID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.db.DeletePostByID(r.Context(), id); err != nil {
+		s.writeDatabaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deletePostBySlug(w http.ResponseWriter, r *http.Request) {
+	post, ok := s.lookupPostBySlug(w, r)
+	if !ok {
+		return
+	}
+
+	if err := s.db.DeletePostByID(r.Context(), int(post.ID)); err != nil {
+		s.writeDatabaseError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) lookupPostByID(w http.ResponseWriter, r *http.Request) (*database.Post, bool) {
+	id, ok := s.pathID(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	post, err := s.db.GetPostByID(r.Context(), id)
+	if err != nil {
+		s.writeDatabaseError(w, err)
+		return nil, false
+	}
+
+	return post, true
+}
+
+func (s *Server) lookupPostBySlug(w http.ResponseWriter, r *http.Request) (*database.Post, bool) {
+	post, redirected, err := s.db.GetPostBySlug(r.Context(), r.PathValue("slug"))
+	if err != nil {
+		s.writeDatabaseError(w, err)
+		return nil, false
+	}
+
+	// Old slugs permanently redirect to the post's current one, whatever the
+	// method, and a 308 tells clients to repeat the same request there
+	if redirected {
+		w.Header().Set("Location", "/api/posts/slug/"+url.PathEscape(post.Slug.String))
+		s.writeError(w, http.StatusPermanentRedirect, fmt.Sprintf("post has moved to %s", post.Slug.String))
+		return nil, false
+	}
+
+	return post, true
+}
+
+// pathID parses the {id} path parameter, writing a 400 response when invalid
+func (s *Server) pathID(w http.ResponseWriter, r *http.Request) (int, bool) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil || id < 1 {
+		s.writeError(w, http.StatusBadRequest, "id must be a positive number")
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) postResponse(ctx context.Context, post *database.Post) (output.Post, error) {
+	tags, err := s.db.GetPostTags(ctx, int(post.ID))
+	if err != nil {
+		return output.Post{}, err
+	}
+	return output.NewPost(post, tags), nil
+}
+
+func (s *Server) writePost(w http.ResponseWriter, ctx context.Context, status int, post *database.Post) {
+	response, err := s.postResponse(ctx, post)
+	if err != nil {
+		s.writeDatabaseError(w, err)
+		return
+	}
+	s.writeJSON(w, status, response)
+}
+
+func queryInt(value string, fallback int) (int, error) {
+	if value == "" {
+		return fallback, nil
+	}
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+)
+
+// ShutdownTimeout is how long in-flight requests get to finish on shutdown
+const ShutdownTimeout = 10 * time.Second
+
+// maxBodySize limits the size of request bodies
+const maxBodySize = 1 << 20
+
+// Server exposes the posts in a database as a JSON REST API
+type Server struct {
+	db     database.Storage
+	mux    *http.ServeMux
+	logger *log.Logger
+	token  string
+}
+
+// Option defines a function type for configuring a Server
+type Option func(*Server)
+
+// WithLogger returns an Option to configure where requests are logged
+func WithLogger(logger *log.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithToken returns an Option requiring requests that change posts to send
+// the token as a bearer token in their Authorization header
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// New creates a new Server backed by the given database
+func New(db database.Storage, opts ...Option) *Server {
+	s := &Server{
+		db:     db,
+		mux:    http.NewServeMux(),
+		logger: log.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.routes()
+
+	return s
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /api/posts", s.listPosts)
+	s.mux.HandleFunc("POST /api/posts", s.createPost)
+	s.mux.HandleFunc("GET /api/posts/{id}", s.getPostByID)
+	s.mux.HandleFunc("PATCH /api/posts/{id}", s.updatePostByID)
+	s.mux.HandleFunc("DELETE /api/posts/{id}", s.deletePostByID)
+	s.mux.HandleFunc("GET /api/posts/slug/{slug}", s.getPostBySlug)
+	s.mux.HandleFunc("PATCH /api/posts/slug/{slug}", s.updatePostBySlug)
+	s.mux.HandleFunc("DELETE /api/posts/slug/{slug}", s.deletePostBySlug)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+	if s.authorized(r) {
+		s.mux.ServeHTTP(recorder, r)
+	} else {
+		recorder.Header().Set("WWW-Authenticate", `Bearer realm="cms"`)
+		s.writeError(recorder, http.StatusUnauthorized, "a valid bearer token is required")
+	}
+
+	s.logger.Printf("%s %s %d %s", r.Method, r.URL.RequestURI(), recorder.status, time.Since(start).Round(time.Microsecond))
+}
+
+// authorized reports whether a request may be served. Reading posts is always
+// allowed, while any other request needs the token when one is set
+func (s *Server) authorized(r *http.Request) bool {
+	if s.token == "" || r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+}
+
+// ListenAndServe serves the API on addr until ctx is cancelled, then shuts down
+// gracefully, giving in-flight requests ShutdownTimeout to complete. If ready
+// is not nil, it receives the address being listened on once the server is up
+func (s *Server) ListenAndServe(ctx context.Context, addr string, ready func(net.Addr)) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(listener)
+	}()
+
+	if ready != nil {
+		ready(listener.Addr())
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// statusRecorder captures the status code written by a handler for logging
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// errorResponse is the body returned for failed requests
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.logger.Printf("failed to write response: %v", err)
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, status int, message string) {
+	s.writeJSON(w, status, errorResponse{Error: message})
+}
+
+// writeDatabaseError maps database errors onto HTTP status codes
+func (s *Server) writeDatabaseError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, database.ErrPostNotFound):
+		s.writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, database.ErrSlugTaken):
+		s.writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, database.ErrInvalidSlug), errors.Is(err, database.ErrAuthorNotFound):
+		s.writeError(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		s.logger.Printf("database error: %v", err)
+		s.writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// decodeJSON decodes a request body, rejecting unknown fields and trailing data
+func decodeJSON(r *http.Request, v any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+
+	if decoder.More() {
+		return errors.New("request body must contain a single JSON object")
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/output"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestServer(t *testing.T) *Server {
+	db, err := database.New(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err, "Failed to create test database")
+	t.Cleanup(func() { db.Close() })
+
+	return New(db, WithLogger(log.New(io.Discard, "", 0)))
+}
+
+func doRequest(t *testing.T, s *Server, method, path, body string) *httptest.ResponseRecorder {
+	var reader io.Reader
+	if body != "" {
+		reader = bytes.NewBufferString(body)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodePost(t *testing.T, rec *httptest.ResponseRecorder) output.Post {
+	var post output.Post
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&post))
+	return post
+}
+
+func TestListPosts(t *testing.T) {
+	s := setupTestServer(t)
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		wantCount  int
+	}{
+		{name: "default", path: "/api/posts", wantStatus: http.StatusOK, wantCount: 2},
+		{name: "paginated", path: "/api/posts?limit=1&offset=1", wantStatus: http.StatusOK, wantCount: 1},
+		{name: "status filter", path: "/api/posts?status=draft", wantStatus: http.StatusOK, wantCount: 0},
+		{name: "status and tag filters", path: "/api/posts?status=published&tag=go", wantStatus: http.StatusOK, wantCount: 0},
+		{name: "invalid limit", path: "/api/posts?limit=abc", wantStatus: http.StatusBadRequest},
+		{name: "limit too large", path: "/api/posts?limit=1000", wantStatus: http.StatusBadRequest},
+		{name: "negative offset", path: "/api/posts?offset=-1", wantStatus: http.StatusBadRequest},
+		{name: "invalid status", path: "/api/posts?status=nope", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRequest(t, s, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			if tt.wantStatus == http.StatusOK {
+				var response listPostsResponse
+				require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+				assert.Len(t, response.Posts, tt.wantCount)
+			}
+		})
+	}
+
+	// The total counts every matching post, not just the page
+	rec := doRequest(t, s, http.MethodGet, "/api/posts?limit=1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response listPostsResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Len(t, response.Posts, 1)
+	assert.Equal(t, int64(2), response.Total)
+}
+
+func TestPostLifecycle(t *testing.T) {
+	s := setupTestServer(t)
+
+	// Create
+	rec := doRequest(t, s, http.MethodPost, "/api/posts", `{"title":"Hello API","content":"Body","slug":"hello-api","status":"published","tags":["go"]}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	created := decodePost(t, rec)
+	assert.Equal(t, "Hello API", created.Title)
+	assert.Equal(t, "published", created.Status)
+	assert.Equal(t, []string{"go"}, created.Tags)
+	assert.Equal(t, "/api/posts/3", rec.Header().Get("Location"))
+
+	// Duplicate slug
+	rec = doRequest(t, s, http.MethodPost, "/api/posts", `{"title":"Again","slug":"hello-api"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// Get by id and slug
+	rec = doRequest(t, s, http.MethodGet, "/api/posts/3", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "hello-api", decodePost(t, rec).Slug)
+
+	rec = doRequest(t, s, http.MethodGet, "/api/posts/slug/hello-api", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, int64(3), decodePost(t, rec).ID)
+
+	// Partial update only changes the given fields
+	rec = doRequest(t, s, http.MethodPatch, "/api/posts/slug/hello-api", `{"content":"New body","status":"archived"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated := decodePost(t, rec)
+	assert.Equal(t, "Hello API", updated.Title)
+	assert.Equal(t, "New body", updated.Content)
+	assert.Equal(t, "archived", updated.Status)
+	assert.Equal(t, []string{"go"}, updated.Tags)
+
+	// Renaming the slug redirects the old one
+	rec = doRequest(t, s, http.MethodPatch, "/api/posts/3", `{"slug":"hello-again"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "hello-again", decodePost(t, rec).Slug)
+
+	rec = doRequest(t, s, http.MethodGet, "/api/posts/slug/hello-api", "")
+	assert.Equal(t, http.StatusPermanentRedirect, rec.Code)
+	assert.Equal(t, "/api/posts/slug/hello-again", rec.Header().Get("Location"))
+
+	// Every method is redirected the same way, without changing the post
+	for _, method := range []string{http.MethodPatch, http.MethodDelete} {
+		rec = doRequest(t, s, method, "/api/posts/slug/hello-api", `{"title":"Moved"}`)
+		assert.Equal(t, http.StatusPermanentRedirect, rec.Code, method)
+		assert.Equal(t, "/api/posts/slug/hello-again", rec.Header().Get("Location"), method)
+	}
+
+	rec = doRequest(t, s, http.MethodGet, "/api/posts/3", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "Hello API", decodePost(t, rec).Title)
+
+	// Delete
+	rec = doRequest(t, s, http.MethodDelete, "/api/posts/3", "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = doRequest(t, s, http.MethodGet, "/api/posts/3", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = doRequest(t, s, http.MethodDelete, "/api/posts/slug/hello-api", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestToken(t *testing.T) {
+	db, err := database.New(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	s := New(db, WithLogger(log.New(io.Discard, "", 0)), WithToken("secret"))
+
+	// Reading doesn't need the token
+	rec := doRequest(t, s, http.MethodGet, "/api/posts", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Changing posts does
+	for _, authorization := range []string{"", "Bearer wrong", "secret"} {
+		req := httptest.NewRequest(http.MethodPatch, "/api/posts/1", bytes.NewBufferString(`{"title":"Changed"}`))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec = httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code, authorization)
+		assert.Equal(t, `Bearer realm="cms"`, rec.Header().Get("WWW-Authenticate"))
+	}
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/posts/1", bytes.NewBufferString(`{"title":"Changed"}`))
+	req.Header.Set("Authorization", "Bearer secret")
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Equal(t, "Changed", decodePost(t, rec).Title)
+}
+
+func TestRequestValidation(t *testing.T) {
+	s := setupTestServer(t)
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+	}{
+		{name: "malformed json", method: http.MethodPost, path: "/api/posts", body: `{"title":`, wantStatus: http.StatusBadRequest},
+		{name: "unknown field", method: http.MethodPost, path: "/api/posts", body: `{"title":"x","colour":"red"}`, wantStatus: http.StatusBadRequest},
+		{name: "missing title", method: http.MethodPost, path: "/api/posts", body: `{"content":"x"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "scheduled status", method: http.MethodPost, path: "/api/posts", body: `{"title":"x","status":"scheduled"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "bad slug", method: http.MethodPost, path: "/api/posts", body: `{"title":"x","slug":"a b"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "dot dot slug", method: http.MethodPost, path: "/api/posts", body: `{"title":"x","slug":".."}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "reserved slug on create", method: http.MethodPost, path: "/api/posts", body: `{"title":"x","slug":"feed"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "reserved slug", method: http.MethodPatch, path: "/api/posts/1", body: `{"slug":"feed"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "unknown author on create", method: http.MethodPost, path: "/api/posts", body: `{"title":"x","author":"Nobody"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "unknown author", method: http.MethodPatch, path: "/api/posts/1", body: `{"author":"Nobody"}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "empty update", method: http.MethodPatch, path: "/api/posts/1", body: `{}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "blank title", method: http.MethodPatch, path: "/api/posts/1", body: `{"title":"  "}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "invalid id", method: http.MethodGet, path: "/api/posts/abc", wantStatus: http.StatusBadRequest},
+		{name: "missing post", method: http.MethodPatch, path: "/api/posts/999", body: `{"title":"x"}`, wantStatus: http.StatusNotFound},
+		{name: "wrong method", method: http.MethodPut, path: "/api/posts/1", body: `{"title":"x"}`, wantStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doRequest(t, s, tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestListenAndServeShutsDown(t *testing.T) {
+	s := setupTestServer(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	addrCh := make(chan net.Addr, 1)
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- s.ListenAndServe(ctx, "127.0.0.1:0", func(addr net.Addr) { addrCh <- addr })
+	}()
+
+	addr := <-addrCh
+	resp, err := http.Get("http://" + addr.String() + "/api/posts")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cancel()
+
+	select {
+	case err := <-errCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not shut down")
+	}
+}
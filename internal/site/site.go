@@ -0,0 +1,479 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+)
+
+//go:embed templates/*.html
+var defaultTemplates embed.FS
+
+//go:embed static
+var defaultStatic embed.FS
+
+const (
+	// DefaultPageSize is the number of posts on each index page
+	DefaultPageSize = 10
+
+	indexTemplate = "index.html"
+	postTemplate  = "post.html"
+)
+
+// Config controls how a site is built
+type Config struct {
+	// OutDir is the directory the site is written to
+	OutDir string
+	// TemplateDir holds html/template files overriding the built-in index.html,
+	// post.html and partials.html. Every *.html file in it is parsed
+	TemplateDir string
+	// StaticDir is copied as-is into OutDir. When no TemplateDir is given the
+	// built-in stylesheet is written as well
+	StaticDir string
+	// Title is the name of the site
+	Title string
+	// BaseURL is prepended to every link, "/" by default
+	BaseURL string
+	// PageSize is the number of posts per index page
+	PageSize int
+	// MediaDir is the media library's store. Files posts refer to with
+	// media: references are copied from it into OutDir/media
+	MediaDir string
+}
+
+// Result summarises a build
+type Result struct {
+	Posts   int
+	Pages   int
+	Assets  int
+	Media   int
+	Skipped []string
+}
+
+// Site is the site-wide data available to templates
+type Site struct {
+	Title   string
+	BaseURL string
+	BuiltAt time.Time
+}
+
+// Post is a post as seen by templates
+type Post struct {
+	ID          int64
+	Title       string
+	Slug        string
+	Author      string
+	Tags        []string
+	URL         string
+	Content     template.HTML
+	Excerpt     template.HTML
+	PublishedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IndexPage is the data passed to index.html
+type IndexPage struct {
+	Site       Site
+	Title      string
+	Posts      []Post
+	Page       int
+	TotalPages int
+	PrevURL    string
+	NextURL    string
+}
+
+// PostPage is the data passed to post.html
+type PostPage struct {
+	Site  Site
+	Title string
+	Post  Post
+}
+
+// Build renders every published post with a slug into cfg.OutDir, along with
+// paginated index pages and static assets
+func Build(ctx context.Context, db database.Storage, cfg Config) (*Result, error) {
+	if cfg.OutDir == "" {
+		return nil, fmt.Errorf("output directory is required")
+	}
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = DefaultPageSize
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = "/"
+	}
+
+	site := Site{
+		Title:   cfg.Title,
+		BaseURL: cfg.BaseURL,
+		BuiltAt: time.Now(),
+	}
+
+	tmpl, err := loadTemplates(cfg.TemplateDir, site)
+	if err != nil {
+		return nil, err
+	}
+
+	pages, skipped, err := LoadPosts(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	// Slugs are validated when posts are saved, but a database from before
+	// that may still hold slugs that lead out of the posts directory
+	kept := pages[:0]
+	for _, post := range pages {
+		if _, ok := postPath(cfg.OutDir, post.Slug); !ok {
+			skipped = append(skipped, fmt.Sprintf("post %d has a slug that can't be used as a directory name: %q", post.ID, post.Slug))
+			continue
+		}
+		kept = append(kept, post)
+	}
+	pages = kept
+
+	result := &Result{Skipped: skipped}
+
+	if err := publishMedia(ctx, db, cfg, pages, result); err != nil {
+		return nil, err
+	}
+
+	for _, post := range pages {
+		data := PostPage{Site: site, Title: post.Title, Post: post}
+		path, _ := postPath(cfg.OutDir, post.Slug)
+		if err := render(tmpl, postTemplate, path, data); err != nil {
+			return nil, err
+		}
+		result.Posts++
+	}
+
+	totalPages := max(1, (len(pages)+cfg.PageSize-1)/cfg.PageSize)
+	for page := 1; page <= totalPages; page++ {
+		start := (page - 1) * cfg.PageSize
+		end := min(start+cfg.PageSize, len(pages))
+
+		data := IndexPage{
+			Site:       site,
+			Posts:      pages[start:end],
+			Page:       page,
+			TotalPages: totalPages,
+		}
+		if page > 1 {
+			data.PrevURL = indexURL(page - 1)
+		}
+		if page < totalPages {
+			data.NextURL = indexURL(page + 1)
+		}
+
+		if err := render(tmpl, indexTemplate, filepath.Join(cfg.OutDir, filepath.FromSlash(indexURL(page)), "index.html"), data); err != nil {
+			return nil, err
+		}
+		result.Pages++
+	}
+
+	if cfg.TemplateDir == "" {
+		static, err := fs.Sub(defaultStatic, "static")
+		if err != nil {
+			return nil, err
+		}
+		count, err := copyDir(static, cfg.OutDir)
+		if err != nil {
+			return nil, err
+		}
+		result.Assets += count
+	}
+
+	if cfg.StaticDir != "" {
+		count, err := copyDir(os.DirFS(cfg.StaticDir), cfg.OutDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy static assets: %w", err)
+		}
+		result.Assets += count
+	}
+
+	return result, nil
+}
+
+// publishMedia copies the media files posts refer to into the site and points
+// their references at the copies. References to files that aren't in the
+// library are left alone and reported as skipped
+func publishMedia(ctx context.Context, db database.Storage, cfg Config, pages []Post, result *Result) error {
+	store := media.NewStore(cfg.MediaDir)
+	urls := map[string]string{}
+	copied := map[string]bool{}
+
+	var copyErr error
+	resolve := func(post Post) func(string) string {
+		return func(prefix string) string {
+			if url, ok := urls[prefix]; ok {
+				return url
+			}
+
+			file, err := db.GetMedia(ctx, prefix)
+			if err != nil {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("post %s refers to media:%s: %v", post.Slug, prefix, err))
+				urls[prefix] = media.Scheme + prefix
+				return urls[prefix]
+			}
+
+			name := MediaURL(file.Hash, media.Ext(file.Filename, file.MimeType))
+			if !copied[file.Hash] {
+				copied[file.Hash] = true
+				src := store.Path(file.Hash)
+				dest := filepath.Join(cfg.OutDir, filepath.FromSlash(name))
+				if err := copyFile(os.DirFS(filepath.Dir(src)), filepath.Base(src), dest); err != nil && copyErr == nil {
+					copyErr = fmt.Errorf("failed to copy media %s: %w", file.Filename, err)
+				}
+				result.Media++
+			}
+
+			urls[prefix] = JoinURL(cfg.BaseURL, name)
+			return urls[prefix]
+		}
+	}
+
+	for i, post := range pages {
+		replace := resolve(post)
+		pages[i].Content = template.HTML(media.ReplaceReferences(string(post.Content), replace))
+		pages[i].Excerpt = template.HTML(media.ReplaceReferences(string(post.Excerpt), replace))
+	}
+
+	return copyErr
+}
+
+// MediaURL returns the path of a media file relative to the site root
+func MediaURL(hash, ext string) string {
+	return "media/" + hash + ext
+}
+
+// LoadPosts loads every published post that has a slug, newest first. It also
+// returns a description of each post that was left out
+func LoadPosts(ctx context.Context, db database.Storage) ([]Post, []string, error) {
+	posts, err := db.ListPostsByStatus(ctx, database.StatusPublished, 0, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list posts: %w", err)
+	}
+
+	var pages []Post
+	var skipped []string
+	for _, post := range posts {
+		if !post.Slug.Valid || post.Slug.String == "" {
+			skipped = append(skipped, fmt.Sprintf("post %d has no slug", post.ID))
+			continue
+		}
+
+		tags, err := db.GetPostTags(ctx, int(post.ID))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get tags for %s: %w", post.Slug.String, err)
+		}
+
+		page, err := NewPost(post, tags)
+		if err != nil {
+			return nil, nil, err
+		}
+		pages = append(pages, page)
+	}
+
+	// Newest posts first
+	sort.SliceStable(pages, func(i, j int) bool {
+		return pages[i].PublishedAt.After(pages[j].PublishedAt)
+	})
+
+	return pages, skipped, nil
+}
+
+// PostURL returns the path of a post's page relative to the site root
+func PostURL(slug string) string {
+	return "posts/" + slug + "/"
+}
+
+// postPath returns where the page of the post with a slug is written. ok is
+// false unless the page gets a directory of its own under outDir/posts, as it
+// wouldn't for slugs such as .. that would overwrite other pages or escape
+// outDir altogether
+func postPath(outDir, slug string) (path string, ok bool) {
+	postsDir := filepath.Join(outDir, "posts")
+	dir := filepath.Join(postsDir, filepath.FromSlash(slug))
+	if filepath.Dir(dir) != postsDir {
+		return "", false
+	}
+	return filepath.Join(dir, "index.html"), true
+}
+
+// indexURL returns the path of an index page relative to the site root
+func indexURL(page int) string {
+	if page <= 1 {
+		return ""
+	}
+	return fmt.Sprintf("page/%d/", page)
+}
+
+// JoinURL joins a base URL and a path relative to the site root
+func JoinURL(baseURL, p string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(p, "/")
+}
+
+// loadTemplates parses the built-in templates and then any templates in dir,
+// so user templates replace the built-in ones with the same name
+func loadTemplates(dir string, site Site) (*template.Template, error) {
+	funcs := template.FuncMap{
+		"url": func(p string) string {
+			return JoinURL(site.BaseURL, p)
+		},
+	}
+
+	tmpl, err := template.New("site").Funcs(funcs).ParseFS(defaultTemplates, "templates/*.html")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse built-in templates: %w", err)
+	}
+
+	if dir == "" {
+		return tmpl, nil
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.html"))
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no *.html templates found in %s", dir)
+	}
+
+	tmpl, err = tmpl.ParseFiles(matches...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse templates: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+// NewPost converts a database post into template data, rendering its Markdown.
+// PublishedAt falls back to CreatedAt for posts that were never published
+func NewPost(post *database.Post, tags []database.Tag) (Post, error) {
+	markdown := database.NullStringToString(post.Content)
+
+	content, err := RenderMarkdown(markdown)
+	if err != nil {
+		return Post{}, fmt.Errorf("failed to render %s: %w", post.Slug.String, err)
+	}
+
+	// The excerpt is the first paragraph of the post
+	firstParagraph, _, _ := strings.Cut(strings.TrimSpace(markdown), "\n\n")
+	excerpt, err := RenderMarkdown(firstParagraph)
+	if err != nil {
+		return Post{}, fmt.Errorf("failed to render %s: %w", post.Slug.String, err)
+	}
+
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+
+	publishedAt := post.PublishedAt
+	if !publishedAt.Valid {
+		publishedAt = post.CreatedAt
+	}
+
+	return Post{
+		ID:          post.ID,
+		Title:       post.Title,
+		Slug:        post.Slug.String,
+		Author:      database.NullStringToString(post.Author),
+		Tags:        names,
+		URL:         PostURL(post.Slug.String),
+		Content:     content,
+		Excerpt:     excerpt,
+		PublishedAt: database.NullTimeToTime(publishedAt),
+		CreatedAt:   database.NullTimeToTime(post.CreatedAt),
+		UpdatedAt:   database.NullTimeToTime(post.UpdatedAt),
+	}, nil
+}
+
+var markdownRenderer = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+)
+
+// RenderMarkdown converts Markdown to HTML. Raw HTML in the source is omitted
+func RenderMarkdown(source string) (template.HTML, error) {
+	var buf bytes.Buffer
+	if err := markdownRenderer.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+	return template.HTML(buf.String()), nil
+}
+
+// render executes a named template into a file, creating its directory
+func render(tmpl *template.Template, name, dest string, data any) error {
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(dest, buf.Bytes(), 0644)
+}
+
+// copyDir copies every file in src into dest, returning the number copied
+func copyDir(src fs.FS, dest string) (int, error) {
+	count := 0
+	err := fs.WalkDir(src, ".", func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(p))
+		if entry.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+
+		if err := copyFile(src, p, target); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+
+	return count, err
+}
+
+func copyFile(src fs.FS, name, dest string) error {
+	in, err := src.Open(name)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,182 @@
+package site
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/media"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestDB(t *testing.T) *database.Database {
+	db, err := database.New(context.Background(), filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err, "Failed to create test database")
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestBuild(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	out := t.TempDir()
+
+	_, err := db.CreateAuthor(ctx, database.Author{Name: "Jane"})
+	require.NoError(t, err)
+
+	post, err := db.CreatePost(ctx, database.CreatePostFromInput("Markdown post", "Intro paragraph.\n\n## Section\n\n<script>alert(1)</script>", "Jane", "markdown-post"))
+	require.NoError(t, err)
+	_, err = db.PublishPost(ctx, int(post.ID))
+	require.NoError(t, err)
+	_, err = db.SetPostTags(ctx, int(post.ID), []string{"go"})
+	require.NoError(t, err)
+
+	_, err = db.CreatePost(ctx, database.CreatePostFromInput("Draft", "Not ready", "", "draft-post"))
+	require.NoError(t, err)
+
+	result, err := Build(ctx, db, Config{OutDir: out, Title: "Test Blog", PageSize: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.Posts, "Only published posts are built")
+	assert.Equal(t, 2, result.Pages)
+	assert.Equal(t, 1, result.Assets)
+
+	page := readFile(t, filepath.Join(out, "posts", "markdown-post", "index.html"))
+	assert.Contains(t, page, "<title>Markdown post · Test Blog</title>")
+	assert.Contains(t, page, `<h2 id="section">Section</h2>`)
+	assert.Contains(t, page, "#go")
+	assert.NotContains(t, page, "<script>", "Raw HTML should be omitted")
+
+	assert.NoDirExists(t, filepath.Join(out, "posts", "draft-post"))
+
+	index := readFile(t, filepath.Join(out, "index.html"))
+	assert.Contains(t, index, `href="/posts/markdown-post/"`, "Newest post comes first")
+	assert.Contains(t, index, "<p>Intro paragraph.</p>")
+	assert.Contains(t, index, `href="/page/2/"`)
+
+	second := readFile(t, filepath.Join(out, "page", "2", "index.html"))
+	assert.Contains(t, second, "Page 2 of 2")
+	assert.Contains(t, second, `href="/"`)
+
+	assert.FileExists(t, filepath.Join(out, "style.css"))
+}
+
+func TestBuildSkipsUnsafeSlugs(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	db, err := database.New(ctx, dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	root := t.TempDir()
+	out := filepath.Join(root, "site")
+
+	// Slugs like these are rejected when posts are saved, so they're written
+	// straight into the database as an older version of cms might have
+	conn, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	unsafe := []string{"..", ".", "../../escaped", "nested/slug"}
+	for i, slug := range unsafe {
+		post, err := db.CreatePost(ctx, database.CreatePostFromInput(fmt.Sprintf("Unsafe %d", i), "Overwritten", "", fmt.Sprintf("unsafe-%d", i)))
+		require.NoError(t, err)
+		_, err = db.PublishPost(ctx, int(post.ID))
+		require.NoError(t, err)
+		_, err = conn.ExecContext(ctx, "UPDATE posts SET slug = ? WHERE id = ?", slug, post.ID)
+		require.NoError(t, err)
+	}
+
+	result, err := Build(ctx, db, Config{OutDir: out, Title: "Test Blog"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.Posts, "Only the sample posts are built")
+	assert.Len(t, result.Skipped, len(unsafe))
+
+	assert.NotContains(t, readFile(t, filepath.Join(out, "index.html")), "Unsafe")
+	assert.NoFileExists(t, filepath.Join(out, "posts", "index.html"))
+	assert.NoDirExists(t, filepath.Join(out, "posts", "nested"))
+	assert.NoDirExists(t, filepath.Join(root, "escaped"))
+}
+
+func TestBuildCustomTemplatesAndStatic(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	out := t.TempDir()
+	templates := t.TempDir()
+	static := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(templates, "post.html"), []byte(`<h1>{{.Post.Title}}</h1><a href="{{url .Post.URL}}">self</a>`), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(static, "img"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(static, "img", "logo.png"), []byte("png"), 0644))
+
+	result, err := Build(ctx, db, Config{
+		OutDir:      out,
+		TemplateDir: templates,
+		StaticDir:   static,
+		BaseURL:     "https://example.com/blog/",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Assets, "Built-in assets are skipped with custom templates")
+
+	page := readFile(t, filepath.Join(out, "posts", "welcome-to-cms", "index.html"))
+	assert.Equal(t, `<h1>Welcome to our CMS</h1><a href="https://example.com/blog/posts/welcome-to-cms/">self</a>`, page)
+
+	// index.html falls back to the built-in template
+	assert.Contains(t, readFile(t, filepath.Join(out, "index.html")), "Getting Started Guide")
+	assert.FileExists(t, filepath.Join(out, "img", "logo.png"))
+	assert.NoFileExists(t, filepath.Join(out, "style.css"))
+}
+
+func TestBuildMedia(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	out := t.TempDir()
+	src := t.TempDir()
+
+	store := media.NewStore(filepath.Join(t.TempDir(), "media"))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "photo.jpg"), []byte("not really a jpeg"), 0644))
+	file, err := store.Add(filepath.Join(src, "photo.jpg"))
+	require.NoError(t, err)
+	_, _, err = db.AddMedia(ctx, database.Media{Hash: file.Hash, Filename: file.Filename, MimeType: file.MimeType, Size: file.Size})
+	require.NoError(t, err)
+
+	ref := media.Ref(file.Hash)
+	content := fmt.Sprintf("![Photo](%s)\n\nAgain: ![Same](%s) and ![Missing](media:ffffffffffff)", ref, ref)
+	post, err := db.CreatePost(ctx, database.CreatePostFromInput("With media", content, "", "with-media"))
+	require.NoError(t, err)
+	_, err = db.PublishPost(ctx, int(post.ID))
+	require.NoError(t, err)
+
+	result, err := Build(ctx, db, Config{OutDir: out, BaseURL: "https://example.com/blog/", MediaDir: store.Dir()})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Media, "each file is copied once")
+	assert.Equal(t, "not really a jpeg", readFile(t, filepath.Join(out, "media", file.Hash+".jpg")))
+
+	page := readFile(t, filepath.Join(out, "posts", "with-media", "index.html"))
+	assert.Contains(t, page, `src="https://example.com/blog/media/`+file.Hash+`.jpg"`)
+	assert.Contains(t, page, `src="media:ffffffffffff"`, "unknown references are left alone")
+
+	require.Len(t, result.Skipped, 1)
+	assert.Contains(t, result.Skipped[0], "media:ffffffffffff")
+}
+
+func TestBuildInvalidTemplate(t *testing.T) {
+	templates := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(templates, "post.html"), []byte(`{{.Post.Title`), 0644))
+
+	_, err := Build(context.Background(), setupTestDB(t), Config{OutDir: t.TempDir(), TemplateDir: templates})
+	assert.Error(t, err)
+}
@@ -0,0 +1,185 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength is the longest slug Make returns, in runes
+const MaxLength = 80
+
+// fallback is used when a title has nothing to make a slug from
+const fallback = "post"
+
+// reserved are slugs that clash with routes on the server or a static site
+var reserved = map[string]bool{
+	"admin":  true,
+	"api":    true,
+	"atom":   true,
+	"edit":   true,
+	"feed":   true,
+	"index":  true,
+	"new":    true,
+	"page":   true,
+	"posts":  true,
+	"rss":    true,
+	"search": true,
+	"static": true,
+	"tags":   true,
+}
+
+// transliterations are letters that don't decompose into a base letter and
+// combining marks, so NFKD alone would drop them
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'ø': "o", 'œ': "oe", 'ł': "l", 'đ': "d", 'ð': "d",
+	'þ': "th", 'ı': "i", 'ħ': "h", 'ŋ': "ng",
+
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g",
+
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+	'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+	'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Make turns a title into a URL-friendly slug. Accented letters lose their
+// accents, Cyrillic and Greek are transliterated and other letters, such as
+// CJK, are kept as they are. Everything else separates words, which are
+// joined with hyphens. The result is at most MaxLength runes, cut at a word
+// boundary where possible, and may be empty
+func Make(title string) string {
+	var b strings.Builder
+	hyphen := false
+
+	write := func(s string) {
+		if s == "" {
+			return
+		}
+		if hyphen && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		hyphen = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(title) {
+		if t, ok := transliterations[r]; ok {
+			write(t)
+			continue
+		}
+
+		// Accented letters are split into a base letter and combining marks,
+		// and the base letter may need transliterating too
+		for _, r := range norm.NFKD.String(string(r)) {
+			t, ok := transliterations[r]
+			switch {
+			case ok:
+				write(t)
+			case unicode.Is(unicode.Mn, r):
+				// Combining marks left over from decomposing accented letters
+			case unicode.IsLetter(r) || unicode.IsDigit(r):
+				write(string(r))
+			case r == '\'' || r == '’':
+				// Keep contractions together, "don't" becomes "dont"
+			default:
+				hyphen = true
+			}
+		}
+	}
+
+	return truncate(norm.NFC.String(b.String()), MaxLength)
+}
+
+// IsReserved reports whether a slug can't be used for a post, either because
+// it clashes with a route or because it would be mistaken for a post ID
+func IsReserved(slug string) bool {
+	if reserved[slug] {
+		return true
+	}
+	_, err := strconv.Atoi(slug)
+	return err == nil
+}
+
+// Validate checks a slug that was given rather than made by Make. It must not
+// be empty or reserved, and may only contain what Make produces: lowercase
+// letters, digits and hyphens. Slugs name files and directories when posts are
+// exported or built into a site and end up in URLs, so punctuation such as
+// dots, slashes, ? and # is rejected
+func Validate(slug string) error {
+	switch {
+	case slug == "":
+		return errors.New("slug cannot be empty")
+	case strings.ContainsFunc(slug, func(r rune) bool { return !isSlugRune(r) }):
+		return fmt.Errorf("%q is not a valid slug, it may only contain lowercase letters, digits and hyphens", slug)
+	case IsReserved(slug):
+		return fmt.Errorf("%q is reserved", slug)
+	}
+	return nil
+}
+
+// isSlugRune reports whether Make can produce r: a hyphen, or a letter or digit
+// that Make keeps as it is
+func isSlugRune(r rune) bool {
+	if r == '-' || r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+		return true
+	}
+	return r > unicode.MaxASCII && Make(string(r)) == string(r)
+}
+
+// Unique returns base, or base with a -2, -3 and so on suffix, whichever is
+// the first that isn't reserved and that taken reports as free. An empty base
+// falls back to "post". The base is shortened so suffixed slugs still fit in
+// MaxLength
+func Unique(ctx context.Context, base string, taken func(context.Context, string) (bool, error)) (string, error) {
+	if base == "" {
+		base = fallback
+	}
+
+	for n := 1; ; n++ {
+		candidate := base
+		if n > 1 {
+			suffix := "-" + strconv.Itoa(n)
+			candidate = truncate(base, MaxLength-len(suffix)) + suffix
+		}
+
+		if IsReserved(candidate) {
+			continue
+		}
+
+		exists, err := taken(ctx, candidate)
+		if err != nil {
+			return "", fmt.Errorf("failed to check slug %q: %w", candidate, err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+}
+
+// truncate shortens a slug to at most max runes, cutting at the last hyphen
+// when there is one, so words aren't split
+func truncate(slug string, max int) string {
+	runes := []rune(slug)
+	if len(runes) <= max {
+		return slug
+	}
+
+	cut := string(runes[:max])
+	if i := strings.LastIndexByte(cut, '-'); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.Trim(cut, "-")
+}
@@ -0,0 +1,202 @@
+package slug
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{
+			name:     "Simple title",
+			title:    "Hello World",
+			expected: "hello-world",
+		},
+		{
+			name:     "Title with special characters",
+			title:    "Hello, World! & More #stuff",
+			expected: "hello-world-more-stuff",
+		},
+		{
+			name:     "Title with numbers",
+			title:    "Top 10 Tips for 2024",
+			expected: "top-10-tips-for-2024",
+		},
+		{
+			name:     "Title with multiple spaces",
+			title:    "Too    Many     Spaces",
+			expected: "too-many-spaces",
+		},
+		{
+			name:     "Title with leading/trailing spaces",
+			title:    "  Trimmed Title  ",
+			expected: "trimmed-title",
+		},
+		{
+			name:     "Empty title",
+			title:    "",
+			expected: "",
+		},
+		{
+			name:     "Only special characters",
+			title:    "!@#$%^&*()",
+			expected: "",
+		},
+		{
+			name:     "Accented letters",
+			title:    "Héllo Wörld",
+			expected: "hello-world",
+		},
+		{
+			name:     "Letters that don't decompose",
+			title:    "Straße, Æsir and Łódź",
+			expected: "strasse-aesir-and-lodz",
+		},
+		{
+			name:     "Cyrillic",
+			title:    "Привет мир",
+			expected: "privet-mir",
+		},
+		{
+			name:     "Greek",
+			title:    "Καλημέρα κόσμε",
+			expected: "kalimera-kosme",
+		},
+		{
+			name:     "CJK is kept",
+			title:    "你好，世界",
+			expected: "你好-世界",
+		},
+		{
+			name:     "Hangul is recomposed",
+			title:    "안녕하세요",
+			expected: "안녕하세요",
+		},
+		{
+			name:     "Contractions",
+			title:    "Don't Panic, It’s Fine",
+			expected: "dont-panic-its-fine",
+		},
+		{
+			name:     "Ligatures and full-width characters",
+			title:    "Eﬃcient Ｇｏ",
+			expected: "efficient-go",
+		},
+		{
+			name:     "Consecutive hyphens",
+			title:    "Word---With---Hyphens",
+			expected: "word-with-hyphens",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Make(tt.title))
+		})
+	}
+}
+
+func TestMakeMaxLength(t *testing.T) {
+	title := strings.Repeat("lorem ipsum ", 20)
+
+	result := Make(title)
+
+	assert.LessOrEqual(t, utf8.RuneCountInString(result), MaxLength)
+	assert.True(t, strings.HasPrefix(title, strings.ReplaceAll(result, "-", " ")), "cut at a word boundary: %q", result)
+	assert.False(t, strings.HasSuffix(result, "-"))
+
+	// A single long word is cut wherever it needs to be
+	result = Make(strings.Repeat("界", MaxLength+10))
+	assert.Equal(t, MaxLength, utf8.RuneCountInString(result))
+}
+
+func TestIsReserved(t *testing.T) {
+	assert.True(t, IsReserved("feed"))
+	assert.True(t, IsReserved("admin"))
+	assert.True(t, IsReserved("42"))
+	assert.False(t, IsReserved("feed-reader"))
+	assert.False(t, IsReserved("top-10"))
+}
+
+func TestValidate(t *testing.T) {
+	valid := []string{"hello-world", "v1-2", "日本語"}
+	for _, s := range valid {
+		assert.NoError(t, Validate(s), s)
+	}
+
+	invalid := []string{
+		"", " ", "hello world", "a/b", `a\b`, ".", "..", "../../etc", "feed", "42",
+		"Hello", "café", "v1.2", "привет", "what?", "a#b", "100%", "$5", "a_b",
+	}
+	for _, s := range invalid {
+		assert.Error(t, Validate(s), s)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	ctx := context.Background()
+
+	takenSlugs := func(slugs ...string) func(context.Context, string) (bool, error) {
+		return func(_ context.Context, s string) (bool, error) {
+			for _, taken := range slugs {
+				if s == taken {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	}
+
+	t.Run("free slug is used as is", func(t *testing.T) {
+		result, err := Unique(ctx, "hello-world", takenSlugs())
+		require.NoError(t, err)
+		assert.Equal(t, "hello-world", result)
+	})
+
+	t.Run("taken slugs are suffixed", func(t *testing.T) {
+		result, err := Unique(ctx, "hello-world", takenSlugs("hello-world", "hello-world-2"))
+		require.NoError(t, err)
+		assert.Equal(t, "hello-world-3", result)
+	})
+
+	t.Run("reserved slugs are suffixed", func(t *testing.T) {
+		result, err := Unique(ctx, "feed", takenSlugs())
+		require.NoError(t, err)
+		assert.Equal(t, "feed-2", result)
+
+		result, err = Unique(ctx, "2024", takenSlugs())
+		require.NoError(t, err)
+		assert.Equal(t, "2024-2", result)
+	})
+
+	t.Run("empty base falls back", func(t *testing.T) {
+		result, err := Unique(ctx, "", takenSlugs("post"))
+		require.NoError(t, err)
+		assert.Equal(t, "post-2", result)
+	})
+
+	t.Run("suffixed slugs fit in the max length", func(t *testing.T) {
+		base := strings.Repeat("a", MaxLength)
+		result, err := Unique(ctx, base, takenSlugs(base))
+		require.NoError(t, err)
+		assert.Equal(t, strings.Repeat("a", MaxLength-2)+"-2", result)
+	})
+
+	t.Run("lookup errors are returned", func(t *testing.T) {
+		lookupErr := errors.New("database is locked")
+		_, err := Unique(ctx, "hello", func(context.Context, string) (bool, error) {
+			return false, lookupErr
+		})
+		assert.ErrorIs(t, err, lookupErr)
+	})
+}
@@ -0,0 +1,422 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/dreamsofcode-io/cli-cms/internal/forms"
+	"github.com/dreamsofcode-io/cli-cms/internal/handler"
+)
+
+var (
+	previewStyle = lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("8")).
+			Padding(0, 1)
+	previewTitleStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	previewMetaStyle  = lipgloss.NewStyle().Faint(true)
+	confirmStyle      = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("9"))
+	errorStyle        = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+)
+
+// browserKeys are the post actions, on top of the list's own keybindings
+type browserKeys struct {
+	edit          key.Binding
+	create        key.Binding
+	delete        key.Binding
+	reload        key.Binding
+	scrollDown    key.Binding
+	scrollUp      key.Binding
+	confirmDelete key.Binding
+	cancelDelete  key.Binding
+}
+
+func newBrowserKeys() browserKeys {
+	return browserKeys{
+		edit: key.NewBinding(
+			key.WithKeys("enter", "e"),
+			key.WithHelp("enter/e", "edit"),
+		),
+		create: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "new post"),
+		),
+		delete: key.NewBinding(
+			key.WithKeys("d", "x"),
+			key.WithHelp("d", "delete"),
+		),
+		reload: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reload"),
+		),
+		scrollDown: key.NewBinding(
+			key.WithKeys("ctrl+d"),
+			key.WithHelp("ctrl+d", "scroll preview down"),
+		),
+		scrollUp: key.NewBinding(
+			key.WithKeys("ctrl+u"),
+			key.WithHelp("ctrl+u", "scroll preview up"),
+		),
+		confirmDelete: key.NewBinding(key.WithKeys("y", "Y")),
+		cancelDelete:  key.NewBinding(key.WithKeys("n", "N", "esc", "q")),
+	}
+}
+
+// postItem is a post in the browser's list
+type postItem struct {
+	post *database.Post
+	tags []string
+}
+
+// Title implements list.DefaultItem
+func (i postItem) Title() string {
+	return i.post.Title
+}
+
+// Description implements list.DefaultItem
+func (i postItem) Description() string {
+	parts := []string{i.post.Status}
+	if i.post.Slug.Valid {
+		parts = append(parts, i.post.Slug.String)
+	}
+	if i.post.Author.Valid && i.post.Author.String != "" {
+		parts = append(parts, "by "+i.post.Author.String)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// FilterValue implements list.Item, matching on the title, slug, author and tags
+func (i postItem) FilterValue() string {
+	return strings.Join(append([]string{
+		i.post.Title,
+		database.NullStringToString(i.post.Slug),
+		database.NullStringToString(i.post.Author),
+	}, i.tags...), " ")
+}
+
+// postsLoadedMsg carries the posts read from the database
+type postsLoadedMsg struct {
+	items []list.Item
+	err   error
+}
+
+// actionDoneMsg reports the result of an edit, create or delete
+type actionDoneMsg struct {
+	status string
+	err    error
+}
+
+// BrowserModel is a full-screen browser for the posts in a database, with a
+// filterable list on the left and a preview of the selected post on the right
+type BrowserModel struct {
+	ctx      context.Context
+	db       database.Storage
+	posts    *handler.Posts
+	keys     browserKeys
+	list     list.Model
+	preview  viewport.Model
+	selected int64
+	deleting *postItem
+	err      error
+	width    int
+	height   int
+}
+
+// NewBrowserModel creates a post browser for db
+func NewBrowserModel(ctx context.Context, db database.Storage) BrowserModel {
+	keys := newBrowserKeys()
+
+	l := list.New(nil, list.NewDefaultDelegate(), 0, 0)
+	l.Title = "Posts"
+	l.SetStatusBarItemName("post", "posts")
+
+	// d is used to delete, so only the arrow and page keys change page
+	l.KeyMap.NextPage = key.NewBinding(
+		key.WithKeys("right", "l", "pgdown"),
+		key.WithHelp("→/l/pgdn", "next page"),
+	)
+	l.KeyMap.PrevPage = key.NewBinding(
+		key.WithKeys("left", "h", "pgup"),
+		key.WithHelp("←/h/pgup", "prev page"),
+	)
+
+	l.AdditionalShortHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.edit, keys.create, keys.delete}
+	}
+	l.AdditionalFullHelpKeys = func() []key.Binding {
+		return []key.Binding{keys.edit, keys.create, keys.delete, keys.reload, keys.scrollDown, keys.scrollUp}
+	}
+
+	return BrowserModel{
+		ctx:     ctx,
+		db:      db,
+		posts:   handler.NewPosts(db),
+		keys:    keys,
+		list:    l,
+		preview: viewport.New(0, 0),
+	}
+}
+
+// Init implements the tea.Model interface
+func (m BrowserModel) Init() tea.Cmd {
+	return tea.Batch(m.list.StartSpinner(), m.loadPosts())
+}
+
+// Update implements the tea.Model interface
+func (m BrowserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.resize()
+		return m, nil
+
+	case postsLoadedMsg:
+		m.list.StopSpinner()
+		m.err = msg.err
+		if msg.err != nil {
+			return m, nil
+		}
+		cmd := m.list.SetItems(msg.items)
+		m.updatePreview(true)
+		return m, cmd
+
+	case actionDoneMsg:
+		m.err = msg.err
+		var cmds []tea.Cmd
+		if msg.status != "" {
+			cmds = append(cmds, m.list.NewStatusMessage(msg.status))
+		}
+		return m, tea.Batch(append(cmds, m.loadPosts())...)
+
+	case tea.KeyMsg:
+		if m.deleting != nil {
+			return m.updateDeleting(msg)
+		}
+
+		// Keys typed into the filter belong to the list
+		if m.list.FilterState() == list.Filtering {
+			break
+		}
+
+		item, ok := m.list.SelectedItem().(postItem)
+		switch {
+		case key.Matches(msg, m.keys.create):
+			return m, m.createPost()
+		case key.Matches(msg, m.keys.reload):
+			return m, m.loadPosts()
+		case key.Matches(msg, m.keys.scrollDown):
+			m.preview.HalfPageDown()
+			return m, nil
+		case key.Matches(msg, m.keys.scrollUp):
+			m.preview.HalfPageUp()
+			return m, nil
+		case key.Matches(msg, m.keys.edit) && ok:
+			return m, m.editPost(item)
+		case key.Matches(msg, m.keys.delete) && ok:
+			m.deleting = &item
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.list, cmd = m.list.Update(msg)
+	m.updatePreview(false)
+	return m, cmd
+}
+
+// updateDeleting handles the answer to the delete confirmation
+func (m BrowserModel) updateDeleting(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.confirmDelete):
+		item := *m.deleting
+		m.deleting = nil
+		return m, m.deletePost(item)
+	case key.Matches(msg, m.keys.cancelDelete):
+		m.deleting = nil
+	}
+	return m, nil
+}
+
+// View implements the tea.Model interface
+func (m BrowserModel) View() string {
+	view := lipgloss.JoinHorizontal(lipgloss.Top,
+		m.list.View(),
+		previewStyle.Render(m.preview.View()),
+	)
+
+	switch {
+	case m.deleting != nil:
+		view += "\n" + confirmStyle.Render(fmt.Sprintf("Move %q to the trash? (y/N)", m.deleting.post.Title))
+	case m.err != nil:
+		view += "\n" + errorStyle.Render("Error: "+m.err.Error())
+	}
+
+	return view
+}
+
+// resize lays out the list and preview for the window size, leaving a line
+// for the confirmation or error message
+func (m *BrowserModel) resize() {
+	height := m.height - 1
+	listWidth := m.width * 2 / 5
+
+	m.list.SetSize(listWidth, height)
+
+	frameWidth, frameHeight := previewStyle.GetFrameSize()
+	m.preview.Width = max(m.width-listWidth-frameWidth, 0)
+	m.preview.Height = max(height-frameHeight, 0)
+	m.updatePreview(true)
+}
+
+// updatePreview shows the selected post in the preview pane. The preview is
+// only rebuilt when the selection changes, unless force is set
+func (m *BrowserModel) updatePreview(force bool) {
+	item, ok := m.list.SelectedItem().(postItem)
+	if !ok {
+		m.selected = 0
+		m.preview.SetContent(previewMetaStyle.Render("No posts"))
+		return
+	}
+
+	if !force && item.post.ID == m.selected {
+		return
+	}
+	m.selected = item.post.ID
+
+	m.preview.SetContent(renderPreview(item, m.preview.Width))
+	m.preview.GotoTop()
+}
+
+// renderPreview formats a post's details and content to fit width
+func renderPreview(item postItem, width int) string {
+	post := item.post
+
+	meta := []string{"Status: " + post.Status}
+	if post.Author.Valid && post.Author.String != "" {
+		meta = append(meta, "Author: "+post.Author.String)
+	}
+	if post.Slug.Valid {
+		meta = append(meta, "Slug: "+post.Slug.String)
+	}
+	if len(item.tags) > 0 {
+		meta = append(meta, "Tags: "+strings.Join(item.tags, ", "))
+	}
+	if post.PublishedAt.Valid {
+		meta = append(meta, "Published: "+post.PublishedAt.Time.Local().Format("2006-01-02 15:04"))
+	}
+	if post.ScheduledFor.Valid {
+		meta = append(meta, "Scheduled: "+post.ScheduledFor.Time.Local().Format("2006-01-02 15:04"))
+	}
+	if post.UpdatedAt.Valid {
+		meta = append(meta, "Updated: "+post.UpdatedAt.Time.Local().Format("2006-01-02 15:04"))
+	}
+
+	content := database.NullStringToString(post.Content)
+	if strings.TrimSpace(content) == "" {
+		content = previewMetaStyle.Render("(no content)")
+	}
+
+	wrap := lipgloss.NewStyle().Width(width)
+	return wrap.Render(previewTitleStyle.Render(post.Title)) + "\n" +
+		wrap.Render(previewMetaStyle.Render(strings.Join(meta, "\n"))) + "\n\n" +
+		wrap.Render(content)
+}
+
+// loadPosts reads every post and its tags
+func (m BrowserModel) loadPosts() tea.Cmd {
+	return func() tea.Msg {
+		posts, err := m.db.ListPosts(m.ctx, 0, 0)
+		if err != nil {
+			return postsLoadedMsg{err: fmt.Errorf("failed to list posts: %w", err)}
+		}
+
+		items := make([]list.Item, len(posts))
+		for i, post := range posts {
+			tags, err := m.db.GetPostTags(m.ctx, int(post.ID))
+			if err != nil {
+				return postsLoadedMsg{err: fmt.Errorf("failed to get post tags: %w", err)}
+			}
+
+			item := postItem{post: post}
+			for _, tag := range tags {
+				item.tags = append(item.tags, tag.Name)
+			}
+			items[i] = item
+		}
+
+		return postsLoadedMsg{items: items}
+	}
+}
+
+// editPost hands the terminal to the editor to edit a post
+func (m BrowserModel) editPost(item postItem) tea.Cmd {
+	var edited *database.Post
+	return tea.Exec(execFunc(func() error {
+		var err error
+		edited, err = m.posts.EditPost(m.ctx, item.post)
+		return err
+	}), func(err error) tea.Msg {
+		if err != nil {
+			return actionDoneMsg{err: err}
+		}
+		return actionDoneMsg{status: fmt.Sprintf("Updated %q", edited.Title)}
+	})
+}
+
+// createPost hands the terminal to the post form, followed by the editor
+func (m BrowserModel) createPost() tea.Cmd {
+	var created *database.Post
+	return tea.Exec(execFunc(func() error {
+		data, err := forms.NewPostForm(forms.PostFormData{}, true)
+		if err != nil {
+			return err
+		}
+
+		created, err = m.posts.CreatePostFromForm(m.ctx, data)
+		return err
+	}), func(err error) tea.Msg {
+		switch {
+		case errors.Is(err, forms.ErrUserCancelled):
+			return actionDoneMsg{status: "Post creation cancelled"}
+		case err != nil:
+			return actionDoneMsg{err: err}
+		}
+		return actionDoneMsg{status: fmt.Sprintf("Created %q", created.Title)}
+	})
+}
+
+// deletePost moves a post to the trash
+func (m BrowserModel) deletePost(item postItem) tea.Cmd {
+	return func() tea.Msg {
+		if err := m.db.DeletePostByID(m.ctx, int(item.post.ID)); err != nil {
+			return actionDoneMsg{err: fmt.Errorf("failed to delete post: %w", err)}
+		}
+		return actionDoneMsg{status: fmt.Sprintf("Moved %q to the trash", item.post.Title)}
+	}
+}
+
+// execFunc runs a function with the terminal released by Bubble Tea, so it can
+// show its own forms or open an editor
+type execFunc func() error
+
+func (f execFunc) Run() error          { return f() }
+func (f execFunc) SetStdin(io.Reader)  {}
+func (f execFunc) SetStdout(io.Writer) {}
+func (f execFunc) SetStderr(io.Writer) {}
+
+// RunBrowser starts the full-screen post browser
+func RunBrowser(ctx context.Context, db database.Storage) error {
+	p := tea.NewProgram(NewBrowserModel(ctx, db), tea.WithAltScreen())
+
+	_, err := p.Run()
+	return err
+}
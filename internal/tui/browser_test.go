@@ -0,0 +1,84 @@
+package tui
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/dreamsofcode-io/cli-cms/internal/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupBrowser(t *testing.T) (BrowserModel, *database.Database) {
+	ctx := context.Background()
+	db, err := database.New(ctx, filepath.Join(t.TempDir(), "test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	m := NewBrowserModel(ctx, db)
+	m = update(t, m, tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = update(t, m, m.loadPosts()())
+	return m, db
+}
+
+func update(t *testing.T, m BrowserModel, msg tea.Msg) BrowserModel {
+	t.Helper()
+	model, _ := m.Update(msg)
+	return model.(BrowserModel)
+}
+
+func keyPress(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestBrowserLoadsPosts(t *testing.T) {
+	m, _ := setupBrowser(t)
+
+	require.Len(t, m.list.Items(), 2)
+	item, ok := m.list.SelectedItem().(postItem)
+	require.True(t, ok)
+	assert.Equal(t, item.post.ID, m.selected)
+	assert.Contains(t, m.View(), item.post.Title)
+}
+
+func TestBrowserDeleteConfirmation(t *testing.T) {
+	ctx := context.Background()
+	m, db := setupBrowser(t)
+	item := m.list.SelectedItem().(postItem)
+
+	// Declining keeps the post
+	m = update(t, m, keyPress("d"))
+	require.NotNil(t, m.deleting)
+	assert.Contains(t, m.View(), "to the trash? (y/N)")
+	m = update(t, m, keyPress("n"))
+	assert.Nil(t, m.deleting)
+
+	// Confirming moves it to the trash
+	m = update(t, m, keyPress("d"))
+	model, cmd := m.Update(keyPress("y"))
+	m = model.(BrowserModel)
+	assert.Nil(t, m.deleting)
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	require.IsType(t, actionDoneMsg{}, msg)
+	require.NoError(t, msg.(actionDoneMsg).err)
+
+	_, err := db.GetPostByID(ctx, int(item.post.ID))
+	assert.ErrorIs(t, err, database.ErrPostNotFound)
+}
+
+func TestPostItemFilterValue(t *testing.T) {
+	item := postItem{
+		post: &database.Post{
+			Title:  "Hello",
+			Slug:   database.StringToNullString("hello"),
+			Author: database.StringToNullString("Jane"),
+		},
+		tags: []string{"go", "cli"},
+	}
+
+	assert.Equal(t, "Hello hello Jane go cli", item.FilterValue())
+}
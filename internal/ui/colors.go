@@ -68,20 +68,32 @@ func ErrorAndExit(format string, args ...interface{}) {
 
 // Header prints a section header
 func Header(text string) {
-	fmt.Printf("\n")
-	Highlight("=== %s ===\n", text)
-	fmt.Printf("\n")
+	fmt.Print(HeaderString(text))
+}
+
+// HeaderString formats a section header
+func HeaderString(text string) string {
+	return "\n" + HighlightString("=== %s ===\n", text) + "\n"
 }
 
 // Subheader prints a subsection header
 func Subheader(text string) {
-	fmt.Printf("\n")
-	Info("--- %s ---\n", text)
+	fmt.Print(SubheaderString(text))
+}
+
+// SubheaderString formats a subsection header
+func SubheaderString(text string) string {
+	return "\n" + InfoString("--- %s ---\n", text)
 }
 
 // Field prints a label-value pair with consistent formatting
 func Field(label string, value interface{}) {
-	fmt.Printf("%s: %v\n", HighlightString(label), value)
+	fmt.Print(FieldString(label, value))
+}
+
+// FieldString formats a label-value pair like Field
+func FieldString(label string, value interface{}) string {
+	return fmt.Sprintf("%s: %v\n", HighlightString(label), value)
 }
 
 // FieldIfNotEmpty prints a field only if the value is not empty
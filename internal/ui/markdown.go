@@ -0,0 +1,23 @@
+package ui
+
+import (
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/glamour/styles"
+	"github.com/fatih/color"
+)
+
+// RenderMarkdown styles Markdown for the terminal, word wrapped to width. The
+// style can be picked with GLAMOUR_STYLE, and is plain when color is disabled
+func RenderMarkdown(content string, width int) (string, error) {
+	style := glamour.WithEnvironmentConfig()
+	if color.NoColor {
+		style = glamour.WithStandardStyle(styles.NoTTYStyle)
+	}
+
+	renderer, err := glamour.NewTermRenderer(style, glamour.WithWordWrap(width))
+	if err != nil {
+		return "", err
+	}
+
+	return renderer.Render(content)
+}
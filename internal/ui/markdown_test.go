@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	originalNoColor := color.NoColor
+	defer func() { color.NoColor = originalNoColor }()
+	color.NoColor = true
+
+	content := "# Heading\n\nSome **bold** text that goes on for long enough that it has to be wrapped onto more lines.\n\n- one\n- two\n"
+
+	rendered, err := RenderMarkdown(content, 40)
+	require.NoError(t, err)
+
+	assert.Contains(t, rendered, "Heading")
+	assert.Contains(t, rendered, "bold")
+	assert.Contains(t, rendered, "one")
+	assert.NotContains(t, rendered, "\x1b[", "no escape codes without color")
+
+	for _, line := range strings.Split(rendered, "\n") {
+		assert.LessOrEqual(t, len(strings.TrimRight(line, " ")), 40, "line should be wrapped: %q", line)
+	}
+}
+
+func TestStringVariants(t *testing.T) {
+	originalNoColor := color.NoColor
+	defer func() { color.NoColor = originalNoColor }()
+	color.NoColor = true
+
+	assert.Equal(t, "Title: Hello\n", FieldString("Title", "Hello"))
+	assert.Equal(t, "\n=== Post ===\n\n", HeaderString("Post"))
+	assert.Equal(t, "\n--- Content ---\n", SubheaderString("Content"))
+}
@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// DefaultWidth is the width output is wrapped to when stdout isn't a terminal
+const DefaultWidth = 80
+
+// IsTerminal reports whether stdin and stdout are both attached to a terminal,
+// so the user can answer prompts
+func IsTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// TerminalSize returns the width and height of the terminal stdout is
+// attached to, or false when stdout isn't a terminal
+func TerminalSize() (int, int, bool) {
+	fd := int(os.Stdout.Fd())
+	if !term.IsTerminal(fd) {
+		return 0, 0, false
+	}
+
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		return 0, 0, false
+	}
+	return width, height, true
+}
+
+// Page prints text to stdout, through $PAGER (less by default) when stdout is a
+// terminal and the text doesn't fit on the screen
+func Page(text string) error {
+	_, height, ok := TerminalSize()
+	if !ok || strings.Count(text, "\n") < height {
+		_, err := os.Stdout.WriteString(text)
+		return err
+	}
+
+	pager := strings.Fields(os.Getenv("PAGER"))
+	if len(pager) == 0 {
+		pager = []string{"less"}
+	}
+
+	if _, err := exec.LookPath(pager[0]); err != nil {
+		_, err := os.Stdout.WriteString(text)
+		return err
+	}
+
+	cmd := exec.Command(pager[0], pager[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// Let less show colors and quit straight away if the text fits after all
+	if os.Getenv("LESS") == "" {
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+
+	return cmd.Run()
+}
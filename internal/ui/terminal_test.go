@@ -0,0 +1,17 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageWithoutTerminal(t *testing.T) {
+	t.Setenv("PAGER", "false")
+
+	output := captureOutput(func() {
+		assert.NoError(t, Page("line one\nline two\n"))
+	})
+
+	assert.Equal(t, "line one\nline two\n", output)
+}
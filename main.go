@@ -4,4 +4,4 @@ import "github.com/dreamsofcode-io/cli-cms/cmd"
 
 func main() {
 	cmd.Execute()
-}
\ No newline at end of file
+}